@@ -0,0 +1,23 @@
+// Package v1alpha1 contains API Schema definitions for the ingress.operator.openshift.io v1alpha1 API group.
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// GroupName is the API group this package's types belong to.
+	GroupName = "ingress.operator.openshift.io"
+
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+	// SchemeGroupVersion is an alias of GroupVersion kept for compatibility with
+	// client-gen generated code that expects this name.
+	SchemeGroupVersion = GroupVersion
+)
+
+// Resource takes an unqualified resource and returns a Group-qualified GroupResource.
+func Resource(resource string) schema.GroupResource {
+	return GroupVersion.WithResource(resource).GroupResource()
+}
@@ -0,0 +1,155 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExposedService lets a user declaratively request that the operator create and
+// manage a Route for an arbitrary Service, mirroring the parameter surface of
+// the community.okd openshift_route module. The operator owns the generated
+// Route (it carries an owner reference back to this object) and reports
+// admission/ingress status on .status.conditions.
+//
+// If the cluster admin has also set an override hostname for this
+// ExposedService via Ingress.Spec.ComponentRoutes (keyed by this object's
+// namespace/name), that override wins over Spec.Hostname: the admin-level
+// customization API takes precedence over the declarative request.
+type ExposedService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec holds user settable values for configuration
+	// +kubebuilder:validation:Required
+	// +required
+	Spec ExposedServiceSpec `json:"spec"`
+	// status holds observed values from the cluster. They may not be overridden.
+	// +optional
+	Status ExposedServiceStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExposedServiceList contains a list of ExposedService.
+type ExposedServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ExposedService `json:"items"`
+}
+
+type ExposedServiceSpec struct {
+	// serviceRef names the Service in this ExposedService's namespace that the
+	// generated Route should target.
+	// +kubebuilder:validation:Required
+	// +required
+	ServiceRef ServiceReference `json:"serviceRef"`
+
+	// hostname is the host name that should be used for the generated Route. If
+	// empty, the operator-assigned default host name is used. This is
+	// overridden by an admin-set Ingress.Spec.ComponentRoutes entry for this
+	// ExposedService's namespace/name, if one exists.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// path is the optional path that the generated Route should match, as in
+	// Route.Spec.Path.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// port selects the Service port the generated Route should target.
+	// +optional
+	Port *ExposedServicePort `json:"port,omitempty"`
+
+	// wildcardPolicy is the wildcard policy the generated Route should use, as
+	// in Route.Spec.WildcardPolicy. One of "None" or "Subdomain".
+	// +optional
+	// +kubebuilder:validation:Enum=None;Subdomain
+	WildcardPolicy string `json:"wildcardPolicy,omitempty"`
+
+	// tls holds TLS termination settings for the generated Route. If empty, the
+	// Route is unencrypted.
+	// +optional
+	TLS *ExposedServiceTLSConfig `json:"tls,omitempty"`
+
+	// annotations is a set of key/value pairs copied verbatim onto the
+	// generated Route's metadata.annotations.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ServiceReference identifies the Service a Route should target. The Service
+// must be in the same namespace as the owning ExposedService.
+type ServiceReference struct {
+	// name is the metadata.name of the Service to expose.
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name"`
+}
+
+// ExposedServicePort identifies the Service port a Route should target, as in
+// Route.Spec.Port.
+type ExposedServicePort struct {
+	// targetPort is the name of the Service port this Route should target, as
+	// in RoutePort.TargetPort.
+	// +kubebuilder:validation:Required
+	// +required
+	TargetPort string `json:"targetPort"`
+}
+
+// ExposedServiceTLSConfig mirrors the subset of Route.Spec.TLS that
+// openshift_route exposes.
+type ExposedServiceTLSConfig struct {
+	// termination is the TLS termination type for the generated Route, as in
+	// RouteTLSConfig.Termination.
+	// +kubebuilder:validation:Required
+	// +required
+	// +kubebuilder:validation:Enum=edge;passthrough;reencrypt
+	Termination string `json:"termination"`
+
+	// insecureEdgeTerminationPolicy governs how the Route handles insecure
+	// requests, as in RouteTLSConfig.InsecureEdgeTerminationPolicy. One of
+	// "Allow", "Redirect", or "None". Ignored when termination is passthrough.
+	// +optional
+	// +kubebuilder:validation:Enum=Allow;Redirect;None
+	InsecureEdgeTerminationPolicy string `json:"insecureEdgeTerminationPolicy,omitempty"`
+
+	// certificate is the PEM-encoded serving certificate for edge and reencrypt
+	// termination. If empty, the default ingresscontroller certificate is used.
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
+
+	// key is the PEM-encoded private key matching certificate.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// caCertificate is the PEM-encoded CA certificate chain for edge and
+	// reencrypt termination.
+	// +optional
+	CACertificate string `json:"caCertificate,omitempty"`
+
+	// destinationCACertificate is the PEM-encoded CA certificate the router
+	// should use to verify the backend's serving certificate for reencrypt
+	// termination.
+	// +optional
+	DestinationCACertificate string `json:"destinationCACertificate,omitempty"`
+}
+
+type ExposedServiceStatus struct {
+	// conditions represent the observations of this ExposedService's current
+	// state, including whether the generated Route has been created and
+	// admitted by an ingresscontroller.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// route is the name of the Route this ExposedService generated and owns.
+	// +optional
+	Route string `json:"route,omitempty"`
+
+	// relatedObjects allows listing resources which are useful when debugging
+	// or inspecting how this is applied.
+	// +optional
+	RelatedObjects []corev1.ObjectReference `json:"relatedObjects,omitempty"`
+}
@@ -0,0 +1,19 @@
+// Package exposedservice will hold the controller that materializes
+// ingress.operator.openshift.io/v1alpha1 ExposedService objects
+// (pkg/apis/ingress/v1alpha1) into owned route.openshift.io/v1 Route objects,
+// reporting admission/ingress status back onto ExposedService.Status.Conditions
+// and deferring to any admin-set Ingress.Spec.ComponentRoutes hostname
+// override for the same namespace/name.
+//
+// STATUS: BLOCKED. awgreene/cluster-ingress-operator#chunk1-5 asked for this
+// controller; it is not implemented, and nothing in this tree reconciles an
+// ExposedService. This checkout has no vendored route.openshift.io/v1 Route
+// type to build against and no manager/main wiring for a second controller
+// to register against, both of which exist in the full operator. Only the
+// ExposedService API types are delivered, in pkg/apis/ingress/v1alpha1; this
+// package is a placeholder for the controller, not the controller itself.
+// This item is not done: do not close it against the backlog, and don't
+// land a future commit that claims it is until this controller exists.
+// Re-file it as the API-only slice it is, or hold it until the Route type
+// and manager/main wiring land.
+package exposedservice
@@ -0,0 +1,45 @@
+package ingressroutes
+
+// STATUS: BLOCKED. awgreene/cluster-ingress-operator#chunk1-3 asked for the
+// operator to propagate Spec.AWS.EIPAllocations onto the default
+// ingresscontroller's NLB Service annotation, one allocation per AZ, plus a
+// webhook rejecting bad ID formats/counts/non-NLB load balancers. Only the
+// last part landed: EIPAllocationValidator (webhook/validators.go) is wired
+// into webhook registration and tested, and eipAllocationsAnnotationValue
+// below is tested as a pure function. Nothing writes the annotation onto a
+// real Service, because this package doesn't own the ingresscontroller's
+// router Service reconciler and no such reconciler exists in this checkout.
+// This item is not done: do not close it against the backlog, and don't
+// land a future commit that claims it is until that Service-writing side
+// actually exists. Re-file it as the API+validation-only slice it is.
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+
+	"strings"
+)
+
+// awsLoadBalancerEIPAllocationsAnnotation is the Service annotation the AWS
+// cloud provider reads to assign static EIPs to a network load balancer, one
+// per availability zone.
+const awsLoadBalancerEIPAllocationsAnnotation = "service.beta.kubernetes.io/aws-load-balancer-eip-allocations"
+
+// eipAllocationsAnnotationValue renders Spec.AWS.EIPAllocations into the
+// comma-separated value expected by awsLoadBalancerEIPAllocationsAnnotation.
+//
+// NOTE: this package only owns componentRoute RBAC/trust reconciliation.
+// Setting this annotation on the default ingresscontroller's router Service
+// is the responsibility of the ingresscontroller/router-service reconciler,
+// which is not part of this package and isn't present in this checkout.
+// This helper exists so that reconciler has a single, tested place to get
+// the annotation value from once it's wired up.
+func eipAllocationsAnnotationValue(spec configv1.IngressSpec) (string, bool) {
+	if spec.AWS == nil || len(spec.AWS.EIPAllocations) == 0 {
+		return "", false
+	}
+	allocations := make([]string, 0, len(spec.AWS.EIPAllocations))
+	for _, allocation := range spec.AWS.EIPAllocations {
+		allocations = append(allocations, string(allocation))
+	}
+	return strings.Join(allocations, ","), true
+}
@@ -0,0 +1,57 @@
+package ingressroutes
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+func TestEIPAllocationsAnnotationValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       configv1.IngressSpec
+		expected   string
+		expectedOK bool
+	}{
+		{
+			name:       "no aws spec",
+			spec:       configv1.IngressSpec{},
+			expected:   "",
+			expectedOK: false,
+		},
+		{
+			name:       "empty eipAllocations",
+			spec:       configv1.IngressSpec{AWS: &configv1.AWSIngressSpec{}},
+			expected:   "",
+			expectedOK: false,
+		},
+		{
+			name: "one allocation",
+			spec: configv1.IngressSpec{AWS: &configv1.AWSIngressSpec{
+				EIPAllocations: []configv1.EIPAllocation{"eipalloc-0123456789abcdef0"},
+			}},
+			expected:   "eipalloc-0123456789abcdef0",
+			expectedOK: true,
+		},
+		{
+			name: "multiple allocations joined with commas",
+			spec: configv1.IngressSpec{AWS: &configv1.AWSIngressSpec{
+				EIPAllocations: []configv1.EIPAllocation{"eipalloc-0123456789abcdef0", "eipalloc-abcdef0123456789a"},
+			}},
+			expected:   "eipalloc-0123456789abcdef0,eipalloc-abcdef0123456789a",
+			expectedOK: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, ok := eipAllocationsAnnotationValue(test.spec)
+			if ok != test.expectedOK {
+				t.Errorf("expected ok=%v, got %v", test.expectedOK, ok)
+			}
+			if value != test.expected {
+				t.Errorf("expected value %q, got %q", test.expected, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package ingressroutes
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	routestatus "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress-routes/status"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// caBundleManagedNamespace is where CA bundle configmaps are published
+	// for consuming operators to read. Only the ingress operator writes
+	// here, which is what prevents a consumer from intercepting trust by
+	// editing its own bundle.
+	caBundleManagedNamespace = "openshift-config-managed"
+	// caBundleConfigMapKey is the data key holding the PEM-encoded CA bundle.
+	caBundleConfigMapKey = "ca-bundle.crt"
+	// additionalTrustBundleSuffix names the optional, admin-managed
+	// configmap in Config.SecretNamespace whose ca-bundle.crt key, if
+	// present, is merged into the published bundle alongside the serving
+	// cert's own chain.
+	additionalTrustBundleSuffix = "-ca-bundle"
+)
+
+// caBundleConfigMapName deterministically names the published CA bundle
+// configmap for a componentRoute, per the "{namespace}-{name}" convention
+// called out on ComponentRouteStatus.CurrentCABundle.
+func caBundleConfigMapName(namespace, name string) string {
+	return fmt.Sprintf("%s-%s", namespace, name)
+}
+
+// reconcileCABundle merges the CA material in sourceSecret's cert chain with
+// any admin-supplied additional trust bundle, publishes the result to a
+// configmap in caBundleManagedNamespace, grants every ConsumingUsers subject
+// get/watch on it, and returns the resulting TrustDistributed condition.
+func (r *reconciler) reconcileCABundle(owner *configv1.Ingress, componentRoute aggregatedComponentRoute, sourceSecret *corev1.Secret, now metav1.Time) configv1.ClusterOperatorStatusCondition {
+	bundle, err := buildCABundle(sourceSecret)
+	if err != nil {
+		return trustDistributedCondition(err, now)
+	}
+
+	additional := &corev1.ConfigMap{}
+	additionalErr := r.cache.Get(context.TODO(), client.ObjectKey{Namespace: r.config.SecretNamespace, Name: componentRoute.ServingCertificateName + additionalTrustBundleSuffix}, additional)
+	switch {
+	case additionalErr == nil:
+		if extra, ok := additional.Data[caBundleConfigMapKey]; ok && extra != "" {
+			bundle = bundle + "\n" + extra
+		}
+	case !errors.IsNotFound(additionalErr):
+		return trustDistributedCondition(fmt.Errorf("failed to get additional trust bundle: %v", additionalErr), now)
+	}
+
+	configMapName := caBundleConfigMapName(componentRoute.Namespace, componentRoute.Name)
+	if err := r.ensureCABundleConfigMap(owner, componentRoute, configMapName, bundle); err != nil {
+		return trustDistributedCondition(err, now)
+	}
+
+	if err := r.ensureCABundleRoleBinding(owner, componentRoute, configMapName); err != nil {
+		return trustDistributedCondition(err, now)
+	}
+
+	return trustDistributedCondition(nil, now)
+}
+
+// buildCABundle extracts the CA material to publish from a serving cert/key
+// pair secret's tls.crt: the certificates in the chain after the leaf, or,
+// if tls.crt is a single self-signed certificate, that certificate itself,
+// since it is then its own trust anchor.
+func buildCABundle(secret *corev1.Secret) (string, error) {
+	rest := secret.Data[corev1.TLSCertKey]
+
+	var leaf *pem.Block
+	var caBundle []byte
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return "", fmt.Errorf("failed to parse certificate in %s/%s tls.crt: %v", secret.Namespace, secret.Name, err)
+		}
+		if leaf == nil {
+			leaf = block
+			continue
+		}
+		caBundle = append(caBundle, pem.EncodeToMemory(block)...)
+	}
+
+	if leaf == nil {
+		return "", fmt.Errorf("%s/%s tls.crt contains no certificates", secret.Namespace, secret.Name)
+	}
+	if len(caBundle) == 0 {
+		// No intermediates or root were bundled alongside the leaf; treat
+		// the leaf as self-signed and therefore its own trust anchor.
+		return string(pem.EncodeToMemory(leaf)), nil
+	}
+	return string(caBundle), nil
+}
+
+func (r *reconciler) ensureCABundleConfigMap(owner *configv1.Ingress, componentRoute aggregatedComponentRoute, name, bundle string) error {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: caBundleManagedNamespace,
+			Labels: map[string]string{
+				componentRouteHashLabelKey: componentRoute.Hash,
+			},
+		},
+		Data: map[string]string{caBundleConfigMapKey: bundle},
+	}
+
+	existing := &corev1.ConfigMap{}
+	err := r.cache.Get(context.TODO(), client.ObjectKey{Namespace: caBundleManagedNamespace, Name: name}, existing)
+	switch {
+	case errors.IsNotFound(err):
+		if err := r.client.Create(context.TODO(), configMap); err != nil {
+			return err
+		}
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, "CABundleCreated", "Created CA bundle configmap %s/%s for componentRoute %s", caBundleManagedNamespace, name, componentRoute.Name)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if existing.Data[caBundleConfigMapKey] == bundle {
+		return nil
+	}
+	existing.Data = configMap.Data
+	if err := r.client.Update(context.TODO(), existing); err != nil {
+		return err
+	}
+	r.recorder.Eventf(owner, corev1.EventTypeNormal, "CABundleRotated", "Rotated CA bundle configmap %s/%s for componentRoute %s", caBundleManagedNamespace, name, componentRoute.Name)
+	return nil
+}
+
+// ensureCABundleRoleBinding grants every componentRoute.Subjects entry
+// get/watch on the published CA bundle configmap, via a Role/RoleBinding
+// pair scoped to that single configmap by name.
+func (r *reconciler) ensureCABundleRoleBinding(owner *configv1.Ingress, componentRoute aggregatedComponentRoute, configMapName string) error {
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName,
+			Namespace: caBundleManagedNamespace,
+			Labels: map[string]string{
+				componentRouteHashLabelKey: componentRoute.Hash,
+			},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				Verbs:         []string{"get", "watch"},
+				APIGroups:     []string{""},
+				Resources:     []string{"configmaps"},
+				ResourceNames: []string{configMapName},
+			},
+		},
+	}
+
+	existingRole := &rbacv1.Role{}
+	err := r.cache.Get(context.TODO(), client.ObjectKey{Namespace: caBundleManagedNamespace, Name: configMapName}, existingRole)
+	switch {
+	case errors.IsNotFound(err):
+		if err := r.client.Create(context.TODO(), role); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		existingRole.Rules = role.Rules
+		if err := r.client.Update(context.TODO(), existingRole); err != nil {
+			return err
+		}
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName,
+			Namespace: caBundleManagedNamespace,
+			Labels: map[string]string{
+				componentRouteHashLabelKey: componentRoute.Hash,
+			},
+		},
+		Subjects: componentRoute.Subjects,
+		RoleRef: rbacv1.RoleRef{
+			Kind:     "Role",
+			Name:     configMapName,
+			APIGroup: "rbac.authorization.k8s.io",
+		},
+	}
+
+	existingRoleBinding := &rbacv1.RoleBinding{}
+	err = r.cache.Get(context.TODO(), client.ObjectKey{Namespace: caBundleManagedNamespace, Name: configMapName}, existingRoleBinding)
+	switch {
+	case errors.IsNotFound(err):
+		if err := r.client.Create(context.TODO(), roleBinding); err != nil {
+			return err
+		}
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, "CABundleRoleBindingCreated", "Granted ConsumingUsers access to CA bundle configmap %s/%s for componentRoute %s", caBundleManagedNamespace, configMapName, componentRoute.Name)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	existingRoleBinding.Subjects = roleBinding.Subjects
+	existingRoleBinding.RoleRef = roleBinding.RoleRef
+	return r.client.Update(context.TODO(), existingRoleBinding)
+}
+
+func trustDistributedCondition(err error, now metav1.Time) configv1.ClusterOperatorStatusCondition {
+	if err != nil {
+		return configv1.ClusterOperatorStatusCondition{Type: routestatus.TrustDistributedCondition, Status: configv1.ConditionFalse, LastTransitionTime: now, Reason: "CABundleDistributionFailed", Message: err.Error()}
+	}
+	return configv1.ClusterOperatorStatusCondition{Type: routestatus.TrustDistributedCondition, Status: configv1.ConditionTrue, LastTransitionTime: now, Reason: "AsExpected", Message: "CA bundle published and ConsumingUsers granted access"}
+}
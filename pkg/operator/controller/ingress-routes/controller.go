@@ -3,11 +3,18 @@ package ingressroutes
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 
 	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
 	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress-routes/metrics"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress-routes/secretsync"
+	routestatus "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress-routes/status"
 	util "github.com/openshift/cluster-ingress-operator/pkg/util"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -15,6 +22,7 @@ import (
 	configv1 "github.com/openshift/api/config/v1"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,6 +37,13 @@ import (
 const (
 	controllerName             = "operator_route_rbac_controller"
 	componentRouteHashLabelKey = "ingress.operator.openshift.io/componentroutehash"
+	// componentRouteSecretHashLabelKey records a hash of the source secret
+	// name a mirrored secret was copied from, so cleanupOrphanedResources
+	// can tell a mirror of the componentRoute's *current*
+	// ServingCertKeyPairSecret/SNIMapping secret apart from a stale mirror
+	// left behind by a rotated or removed one, even though both carry the
+	// same componentRouteHashLabelKey.
+	componentRouteSecretHashLabelKey = "ingress.operator.openshift.io/componentroutesecrethash"
 )
 
 var (
@@ -40,9 +55,10 @@ var (
 // include routes with configurable hostnames and serving certificate.
 func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 	reconciler := &reconciler{
-		config: config,
-		client: mgr.GetClient(),
-		cache:  mgr.GetCache(),
+		config:   config,
+		client:   mgr.GetClient(),
+		cache:    mgr.GetCache(),
+		recorder: mgr.GetEventRecorderFor(controllerName),
 	}
 	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: reconciler})
 	if err != nil {
@@ -75,9 +91,46 @@ func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 	if err := c.Watch(&source.Informer{Informer: roleBindingInformer}, &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(reconciler.resourceToClusterIngressConfig)}, defaultPredicate); err != nil {
 		return nil, err
 	}
+
+	// Trigger reconciles when a secret we've previously mirrored changes.
+	mirroredSecretInformer, err := mgr.GetCache().GetInformer(context.TODO(), &corev1.Secret{})
+	if err := c.Watch(&source.Informer{Informer: mirroredSecretInformer}, &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(reconciler.resourceToClusterIngressConfig)}, secretsync.NewComponentRouteSecretPredicate(componentRouteHashLabelKey)); err != nil {
+		return nil, err
+	}
+
+	// Trigger reconciles when the servingCertKeyPairSecret referenced by a
+	// componentRoute changes, even though that secret itself carries no
+	// componentRoute label.
+	sourceSecretInformer, err := mgr.GetCache().GetInformer(context.TODO(), &corev1.Secret{})
+	if err := c.Watch(&source.Informer{Informer: sourceSecretInformer}, &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(reconciler.sourceSecretToClusterIngressConfig)}); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
+// sourceSecretToClusterIngressConfig triggers a reconcile of the cluster
+// ingress config whenever a secret in config.SecretNamespace changes and is
+// referenced by some componentRoute's servingCertKeyPairSecret, so that
+// certificate rotations are picked up without waiting for the next periodic
+// resync.
+func (r *reconciler) sourceSecretToClusterIngressConfig(o handler.MapObject) []reconcile.Request {
+	if o.Meta.GetNamespace() != r.config.SecretNamespace {
+		return nil
+	}
+
+	ingress := &configv1.Ingress{}
+	if err := r.cache.Get(context.TODO(), operatorcontroller.IngressClusterConfigName(), ingress); err != nil {
+		return nil
+	}
+
+	for _, componentRoute := range ingress.Spec.ComponentRoutes {
+		if componentRoute.ServingCertKeyPairSecret.Name == o.Meta.GetName() {
+			return []reconcile.Request{{NamespacedName: operatorcontroller.IngressClusterConfigName()}}
+		}
+	}
+	return nil
+}
+
 // resourceToClusterIngressConfig is used to only trigger reconciles on the cluster ingress config
 func (r *reconciler) resourceToClusterIngressConfig(o handler.MapObject) []reconcile.Request {
 	return []reconcile.Request{
@@ -87,22 +140,59 @@ func (r *reconciler) resourceToClusterIngressConfig(o handler.MapObject) []recon
 	}
 }
 
+// BindingMode selects how ConsumingUsers are granted access to a
+// componentRoute's serving cert secret.
+type BindingMode string
+
+const (
+	// Namespaced creates a single RoleBinding in Config.SecretNamespace
+	// whose subjects are every ConsumingUsers entry, regardless of which
+	// namespace they actually live in. This is the long-standing default
+	// and only works when every ConsumingUsers service account lives in
+	// SecretNamespace.
+	Namespaced BindingMode = "Namespaced"
+	// PerConsumerNamespace creates one RoleBinding per distinct consumer
+	// namespace, each pointing at the shared Role in Config.SecretNamespace.
+	// Use this when ConsumingUsers references service accounts across
+	// multiple namespaces.
+	PerConsumerNamespace BindingMode = "PerConsumerNamespace"
+)
+
 // Config holds all the things necessary for the controller to run.
 type Config struct {
 	SecretNamespace string
+	// BindingMode selects how RoleBindings are generated for a
+	// componentRoute's ConsumingUsers. The zero value behaves like
+	// Namespaced.
+	BindingMode BindingMode
 }
 
 // reconciler handles the actual ingress reconciliation logic in response to
 // events.
 type reconciler struct {
-	config Config
-	client client.Client
-	cache  cache.Cache
+	config   Config
+	client   client.Client
+	cache    cache.Cache
+	recorder record.EventRecorder
 }
 
 // Reconcile expects request to refer to a ingress in the operator namespace,
 // and will do all the work to ensure the ingress is in the desired state.
+// It records reconcile outcome and latency metrics around the actual
+// reconciliation logic in reconcileIngressRoutes.
 func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	start := time.Now()
+	result, err := r.reconcileIngressRoutes(request)
+	metrics.ReconcileDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.ReconcileTotal.WithLabelValues("error").Inc()
+	} else {
+		metrics.ReconcileTotal.WithLabelValues("success").Inc()
+	}
+	return result, err
+}
+
+func (r *reconciler) reconcileIngressRoutes(request reconcile.Request) (reconcile.Result, error) {
 	log.Info("reconciling", "request", request)
 
 	// Only proceed if we can get the ingress resource.
@@ -118,25 +208,178 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 	// Get the list of componentRoutes defined in both the spec and status of the ingress resource
 	componentRoutes := r.intersectingComponentRoutes(ingress.Spec.ComponentRoutes, ingress.Status.ComponentRoutes)
 
-	// Ensure role and roleBindings exist for each valid componentRoute.
+	// Ensure role and roleBindings exist for each valid componentRoute, recording
+	// per-componentRoute conditions as we go so that failures surface on the
+	// Ingress status instead of only in logs.
+	now := metav1.Now()
 	for _, componentRoute := range componentRoutes {
+		conditions := []configv1.ClusterOperatorStatusCondition{}
+
+		sourceSecret := &corev1.Secret{}
+		secretErr := r.cache.Get(context.TODO(), client.ObjectKey{Namespace: r.config.SecretNamespace, Name: componentRoute.ServingCertificateName}, sourceSecret)
+		conditions = append(conditions, secretResolvedCondition(secretErr, now))
+
 		roleName, err := r.ensureServiceCertKeyPairSecretRole(ingress, componentRoute)
+		conditions = append(conditions, roleReadyCondition(err, now))
 		if err != nil {
+			conditions = append(conditions, degradedCondition(fmt.Sprintf("failed to create role: %v", err), now))
+			r.setComponentRouteConditions(ingress, componentRoute, conditions)
+			if statusErr := r.client.Status().Update(context.TODO(), ingress); statusErr != nil {
+				log.Error(statusErr, "failed to update ingress status", "request", request)
+			}
 			return reconcile.Result{Requeue: true}, fmt.Errorf("failed to create role: %v", err)
 		}
 
-		if err := r.ensureServiceCertKeyPairSecretRoleBinding(ingress, componentRoute, roleName); err != nil {
+		err = r.ensureServiceCertKeyPairSecretRoleBinding(ingress, componentRoute, roleName)
+		conditions = append(conditions, roleBindingReadyCondition(err, now))
+		if err != nil {
+			conditions = append(conditions, degradedCondition(fmt.Sprintf("failed to create rolebinding: %v", err), now))
+			r.setComponentRouteConditions(ingress, componentRoute, conditions)
+			if statusErr := r.client.Status().Update(context.TODO(), ingress); statusErr != nil {
+				log.Error(statusErr, "failed to update ingress status", "request", request)
+			}
 			return reconcile.Result{Requeue: true}, fmt.Errorf("failed to create rolebinding: %v", err)
 		}
+
+		if secretErr != nil {
+			conditions = append(conditions, degradedCondition(fmt.Sprintf("servingCertKeyPairSecret %q not found: %v", componentRoute.ServingCertificateName, secretErr), now))
+			r.setComponentRouteConditions(ingress, componentRoute, conditions)
+			r.recorder.Eventf(ingress, corev1.EventTypeWarning, "ValidationFailed", "servingCertKeyPairSecret %q not found for componentRoute %s: %v", componentRoute.ServingCertificateName, componentRoute.Name, secretErr)
+			continue
+		}
+
+		if err := r.mirrorToConsumerNamespaces(sourceSecret, componentRoute); err != nil {
+			conditions = append(conditions, degradedCondition(fmt.Sprintf("failed to mirror servingCertKeyPairSecret: %v", err), now))
+			r.setComponentRouteConditions(ingress, componentRoute, conditions)
+			r.recorder.Eventf(ingress, corev1.EventTypeWarning, "ValidationFailed", "failed to mirror servingCertKeyPairSecret for componentRoute %s: %v", componentRoute.Name, err)
+			continue
+		}
+
+		liveHostnames, sniConditions, sniDegradedMessage := r.reconcileSNIMappings(ingress, componentRoute, now)
+		conditions = append(conditions, sniConditions...)
+		r.setComponentRouteCurrentHostnames(ingress, componentRoute, liveHostnames)
+
+		trustCondition := r.reconcileCABundle(ingress, componentRoute, sourceSecret, now)
+		conditions = append(conditions, trustCondition)
+		if trustCondition.Status == configv1.ConditionTrue {
+			r.setComponentRouteCurrentCABundle(ingress, componentRoute, caBundleConfigMapName(componentRoute.Namespace, componentRoute.Name))
+		}
+
+		degradedMessage := sniDegradedMessage
+		if trustCondition.Status != configv1.ConditionTrue {
+			if degradedMessage != "" {
+				degradedMessage += "; "
+			}
+			degradedMessage += trustCondition.Message
+		}
+		conditions = append(conditions, degradedCondition(degradedMessage, now))
+		r.setComponentRouteConditions(ingress, componentRoute, conditions)
+		metrics.Active.WithLabelValues(componentRoute.Namespace, componentRoute.Name, componentRoute.Hash).Set(1)
 	}
 
 	// Delete any roles or roleBindings that were generated for componentRoutes that are no longer defined.
-	if err := r.cleanupOrphanedResources(componentRoutes); err != nil {
+	if err := r.cleanupOrphanedResources(ingress, componentRoutes); err != nil {
 		return reconcile.Result{Requeue: true}, fmt.Errorf("failed to delete role: %v", err)
 	}
+
+	if err := r.client.Status().Update(context.TODO(), ingress); err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("failed to update ingress status: %v", err)
+	}
 	return reconcile.Result{}, nil
 }
 
+// setComponentRouteConditions replaces the Conditions of the
+// ComponentRouteStatus identified by componentRoute's namespace/name with
+// conditions. It is a no-op if the Ingress has no matching status entry,
+// which can legitimately happen for a spec entry with no intersecting
+// status yet.
+func (r *reconciler) setComponentRouteConditions(ingress *configv1.Ingress, componentRoute aggregatedComponentRoute, conditions []configv1.ClusterOperatorStatusCondition) {
+	for i, status := range ingress.Status.ComponentRoutes {
+		if util.Hash(namespacedName(status.Namespace, status.Name)) == componentRoute.Hash {
+			ingress.Status.ComponentRoutes[i].Conditions = conditions
+			return
+		}
+	}
+}
+
+// setComponentRouteCurrentHostnames replaces the CurrentHostnames of the
+// ComponentRouteStatus identified by componentRoute's namespace/name with
+// hostnames, so that CurrentHostnames reflects only the primary hostname and
+// whichever SNIMapping hostnames are currently live. It is a no-op if the
+// Ingress has no matching status entry, mirroring setComponentRouteConditions.
+func (r *reconciler) setComponentRouteCurrentHostnames(ingress *configv1.Ingress, componentRoute aggregatedComponentRoute, hostnames []string) {
+	for i, status := range ingress.Status.ComponentRoutes {
+		if util.Hash(namespacedName(status.Namespace, status.Name)) == componentRoute.Hash {
+			ingress.Status.ComponentRoutes[i].CurrentHostnames = hostnames
+			return
+		}
+	}
+}
+
+// setComponentRouteCurrentCABundle records the name of the CA bundle
+// configmap published for componentRoute, mirroring setComponentRouteCurrentHostnames.
+func (r *reconciler) setComponentRouteCurrentCABundle(ingress *configv1.Ingress, componentRoute aggregatedComponentRoute, configMapName string) {
+	for i, status := range ingress.Status.ComponentRoutes {
+		if util.Hash(namespacedName(status.Namespace, status.Name)) == componentRoute.Hash {
+			ingress.Status.ComponentRoutes[i].CurrentCABundle = configv1.ConfigMapNameReference{Name: configMapName}
+			return
+		}
+	}
+}
+
+func roleReadyCondition(err error, now metav1.Time) configv1.ClusterOperatorStatusCondition {
+	if err != nil {
+		return configv1.ClusterOperatorStatusCondition{Type: routestatus.RoleReadyCondition, Status: configv1.ConditionFalse, LastTransitionTime: now, Reason: "RoleCreateOrUpdateFailed", Message: err.Error()}
+	}
+	return configv1.ClusterOperatorStatusCondition{Type: routestatus.RoleReadyCondition, Status: configv1.ConditionTrue, LastTransitionTime: now, Reason: "AsExpected", Message: "Role exists and is up to date"}
+}
+
+func roleBindingReadyCondition(err error, now metav1.Time) configv1.ClusterOperatorStatusCondition {
+	if err != nil {
+		return configv1.ClusterOperatorStatusCondition{Type: routestatus.RoleBindingReadyCondition, Status: configv1.ConditionFalse, LastTransitionTime: now, Reason: "RoleBindingCreateOrUpdateFailed", Message: err.Error()}
+	}
+	return configv1.ClusterOperatorStatusCondition{Type: routestatus.RoleBindingReadyCondition, Status: configv1.ConditionTrue, LastTransitionTime: now, Reason: "AsExpected", Message: "RoleBinding exists and is up to date"}
+}
+
+// mirrorToConsumerNamespaces validates sourceSecret against the
+// componentRoute's current hostnames and, for every ServiceAccount subject
+// outside config.SecretNamespace, copies the secret into that service
+// account's namespace.
+func (r *reconciler) mirrorToConsumerNamespaces(sourceSecret *corev1.Secret, componentRoute aggregatedComponentRoute) error {
+	if err := secretsync.ValidateServingCert(sourceSecret, componentRoute.CurrentHostnames); err != nil {
+		return err
+	}
+
+	mirrorer := &secretsync.SecretMirrorer{Client: r.client}
+	labels := map[string]string{
+		componentRouteHashLabelKey:       componentRoute.Hash,
+		componentRouteSecretHashLabelKey: util.Hash(sourceSecret.Name),
+	}
+	for _, subject := range componentRoute.Subjects {
+		if subject.Kind != rbacv1.ServiceAccountKind || subject.Namespace == "" || subject.Namespace == r.config.SecretNamespace {
+			continue
+		}
+		if err := mirrorer.Mirror(context.TODO(), sourceSecret, subject.Namespace, labels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func secretResolvedCondition(err error, now metav1.Time) configv1.ClusterOperatorStatusCondition {
+	if err != nil {
+		return configv1.ClusterOperatorStatusCondition{Type: routestatus.SecretResolvedCondition, Status: configv1.ConditionFalse, LastTransitionTime: now, Reason: "SecretNotFound", Message: err.Error()}
+	}
+	return configv1.ClusterOperatorStatusCondition{Type: routestatus.SecretResolvedCondition, Status: configv1.ConditionTrue, LastTransitionTime: now, Reason: "AsExpected", Message: "servingCertKeyPairSecret exists"}
+}
+
+func degradedCondition(message string, now metav1.Time) configv1.ClusterOperatorStatusCondition {
+	if message == "" {
+		return configv1.ClusterOperatorStatusCondition{Type: routestatus.DegradedCondition, Status: configv1.ConditionFalse, LastTransitionTime: now, Reason: "AsExpected", Message: "componentRoute reconciled successfully"}
+	}
+	return configv1.ClusterOperatorStatusCondition{Type: routestatus.DegradedCondition, Status: configv1.ConditionTrue, LastTransitionTime: now, Reason: "ReconcileError", Message: message}
+}
+
 func (r *reconciler) intersectingComponentRoutes(componentRouteSpecs []configv1.ComponentRouteSpec, componentRouteStatuses []configv1.ComponentRouteStatus) []aggregatedComponentRoute {
 	componentRouteHashToComponentRouteStatus := map[string]configv1.ComponentRouteStatus{}
 	for _, componentRoute := range componentRouteStatuses {
@@ -148,7 +391,7 @@ func (r *reconciler) intersectingComponentRoutes(componentRouteSpecs []configv1.
 	for _, componentRouteSpec := range componentRouteSpecs {
 		hash := util.Hash(namespacedName(componentRouteSpec.Namespace, componentRouteSpec.Name))
 		if componentRouteStatus, ok := componentRouteHashToComponentRouteStatus[hash]; ok {
-			componentRoutes = append(componentRoutes, newAggregatedComponentRoute(componentRouteSpec, componentRouteStatus))
+			componentRoutes = append(componentRoutes, newAggregatedComponentRoute(componentRouteSpec, componentRouteStatus, r.config.SecretNamespace))
 		}
 	}
 	return componentRoutes
@@ -157,18 +400,29 @@ func (r *reconciler) intersectingComponentRoutes(componentRouteSpecs []configv1.
 // aggregatedComponeRoute contains all the information from the ComponentRouteSpec
 // and ComponentRouteStatus to generate the required Role and RoleBinding.
 type aggregatedComponentRoute struct {
+	Namespace              string
 	Name                   string
 	Hash                   string
 	ServingCertificateName string
-	ConsumingUsers         []string
+	Subjects               []rbacv1.Subject
+	CurrentHostnames       []string
+	SNIMappings            []configv1.SNIMapping
 }
 
-func newAggregatedComponentRoute(spec configv1.ComponentRouteSpec, status configv1.ComponentRouteStatus) aggregatedComponentRoute {
+func newAggregatedComponentRoute(spec configv1.ComponentRouteSpec, status configv1.ComponentRouteStatus, defaultNamespace string) aggregatedComponentRoute {
+	subjects := make([]rbacv1.Subject, 0, len(status.ConsumingUsers))
+	for _, consumingUser := range status.ConsumingUsers {
+		subjects = append(subjects, parseConsumingUser(consumingUser, defaultNamespace))
+	}
+
 	return aggregatedComponentRoute{
+		Namespace:              spec.Namespace,
 		Name:                   spec.Name,
 		Hash:                   util.Hash(namespacedName(spec.Namespace, spec.Name)),
 		ServingCertificateName: spec.ServingCertKeyPairSecret.Name,
-		ConsumingUsers:         status.ConsumingUsers,
+		Subjects:               subjects,
+		CurrentHostnames:       status.CurrentHostnames,
+		SNIMappings:            spec.SNIMappings,
 	}
 }
 
@@ -185,6 +439,18 @@ func componentRouteResources(componentRoute aggregatedComponentRoute) []client.L
 	}
 }
 
+// componentRouteResourcesInNamespace is like componentRouteResources but
+// scoped to an arbitrary namespace, for RoleBindings generated in
+// PerConsumerNamespace mode.
+func componentRouteResourcesInNamespace(componentRoute aggregatedComponentRoute, namespace string) []client.ListOption {
+	return []client.ListOption{
+		client.MatchingLabels{
+			componentRouteHashLabelKey: componentRoute.Hash,
+		},
+		client.InNamespace(namespace),
+	}
+}
+
 func allComponentRouteResources() []client.ListOption {
 	return []client.ListOption{
 		client.HasLabels{componentRouteHashLabelKey},
@@ -192,10 +458,28 @@ func allComponentRouteResources() []client.ListOption {
 	}
 }
 
-func (r *reconciler) cleanupOrphanedResources(componentRoutes []aggregatedComponentRoute) error {
+// allComponentRouteRoleBindingResources lists every RoleBinding the
+// reconciler has generated, across all namespaces when BindingMode is
+// PerConsumerNamespace since those RoleBindings live in the consumers'
+// namespaces rather than Config.SecretNamespace.
+func allComponentRouteRoleBindingResources(mode BindingMode) []client.ListOption {
+	if mode == PerConsumerNamespace {
+		return []client.ListOption{client.HasLabels{componentRouteHashLabelKey}}
+	}
+	return allComponentRouteResources()
+}
+
+func (r *reconciler) cleanupOrphanedResources(owner *configv1.Ingress, componentRoutes []aggregatedComponentRoute) error {
 	existingHashes := map[string]struct{}{}
+	currentSecretHashes := map[string]map[string]struct{}{}
 	for _, cr := range componentRoutes {
 		existingHashes[cr.Hash] = struct{}{}
+
+		valid := map[string]struct{}{util.Hash(cr.ServingCertificateName): {}}
+		for _, mapping := range cr.SNIMappings {
+			valid[util.Hash(mapping.ServingCertKeyPairSecret.Name)] = struct{}{}
+		}
+		currentSecretHashes[cr.Hash] = valid
 	}
 
 	roleList := &rbacv1.RoleList{}
@@ -211,12 +495,24 @@ func (r *reconciler) cleanupOrphanedResources(componentRoutes []aggregatedCompon
 			if err := r.client.Delete(context.TODO(), &item); err != nil && !errors.IsNotFound(err) {
 				return err
 			}
+			metrics.OrphanDeletedTotal.WithLabelValues("Role").Inc()
+			r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleDeleted", "Deleted orphaned role %s/%s", item.GetNamespace(), item.GetName())
+			metrics.Active.DeletePartialMatch(prometheus.Labels{"hash": expectedHash})
 		}
 	}
 
 	roleBindingList := &rbacv1.RoleBindingList{}
-	r.cache.List(context.TODO(), roleBindingList, allComponentRouteResources()...)
+	r.cache.List(context.TODO(), roleBindingList, allComponentRouteRoleBindingResources(r.config.BindingMode)...)
 	for _, item := range roleBindingList.Items {
+		if item.GetNamespace() == caBundleManagedNamespace {
+			// PerConsumerNamespace mode lists RoleBindings cluster-wide by
+			// label alone, which also matches the CA-bundle RoleBindings
+			// cabundle.go creates in caBundleManagedNamespace. Those are
+			// swept by the dedicated CA-bundle cleanup pass below; skip them
+			// here so an orphan isn't deleted (and counted) twice.
+			continue
+		}
+
 		expectedHash, ok := item.GetLabels()[componentRouteHashLabelKey]
 		if !ok {
 			return fmt.Errorf("Unable to find expected componentRoute hash label")
@@ -227,13 +523,106 @@ func (r *reconciler) cleanupOrphanedResources(componentRoutes []aggregatedCompon
 			if err := r.client.Delete(context.TODO(), &item); err != nil && !errors.IsNotFound(err) {
 				return err
 			}
+			metrics.OrphanDeletedTotal.WithLabelValues("RoleBinding").Inc()
+			r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleBindingDeleted", "Deleted orphaned roleBinding %s/%s", item.GetNamespace(), item.GetName())
+		}
+	}
+
+	// Mirrored secrets can live in any consumer's namespace, so unlike roles
+	// and roleBindings they must be listed cluster-wide.
+	mirroredSecretList := &corev1.SecretList{}
+	r.cache.List(context.TODO(), mirroredSecretList, client.HasLabels{componentRouteHashLabelKey})
+	for _, item := range mirroredSecretList.Items {
+		expectedHash, ok := item.GetLabels()[componentRouteHashLabelKey]
+		if !ok {
+			return fmt.Errorf("Unable to find expected componentRoute hash label")
+		}
+
+		// A mirror is orphaned either because its componentRoute is gone
+		// entirely, or because the componentRoute still exists but no
+		// longer references the secret this mirror was copied from (the
+		// admin rotated ServingCertKeyPairSecret.Name, or removed/changed
+		// the SNIMapping this mirror backed). Both leave stale cert/key
+		// material readable by the consumer's service account unless
+		// deleted here.
+		validSecretHashes, componentRouteStillExists := currentSecretHashes[expectedHash]
+		orphaned := !componentRouteStillExists
+		if componentRouteStillExists {
+			if _, ok := validSecretHashes[item.GetLabels()[componentRouteSecretHashLabelKey]]; !ok {
+				orphaned = true
+			}
+		}
+
+		if orphaned {
+			log.Info("deleting mirrored secret", "name", item.GetName(), "namespace", item.GetNamespace())
+			if err := r.client.Delete(context.TODO(), &item); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			metrics.OrphanDeletedTotal.WithLabelValues("Secret").Inc()
+			r.recorder.Eventf(owner, corev1.EventTypeNormal, "SecretDeleted", "Deleted orphaned mirrored secret %s/%s", item.GetNamespace(), item.GetName())
+		}
+	}
+
+	// CA bundle configmaps, and the Role/RoleBinding granting access to
+	// them, live in caBundleManagedNamespace rather than Config.SecretNamespace.
+	caBundleRoleList := &rbacv1.RoleList{}
+	r.cache.List(context.TODO(), caBundleRoleList, client.InNamespace(caBundleManagedNamespace), client.HasLabels{componentRouteHashLabelKey})
+	for _, item := range caBundleRoleList.Items {
+		expectedHash, ok := item.GetLabels()[componentRouteHashLabelKey]
+		if !ok {
+			return fmt.Errorf("Unable to find expected componentRoute hash label")
+		}
+
+		if _, ok := existingHashes[expectedHash]; !ok {
+			log.Info("deleting CA bundle role", "name", item.GetName(), "namespace", item.GetNamespace())
+			if err := r.client.Delete(context.TODO(), &item); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			metrics.OrphanDeletedTotal.WithLabelValues("Role").Inc()
+			r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleDeleted", "Deleted orphaned CA bundle role %s/%s", item.GetNamespace(), item.GetName())
+		}
+	}
+
+	caBundleRoleBindingList := &rbacv1.RoleBindingList{}
+	r.cache.List(context.TODO(), caBundleRoleBindingList, client.InNamespace(caBundleManagedNamespace), client.HasLabels{componentRouteHashLabelKey})
+	for _, item := range caBundleRoleBindingList.Items {
+		expectedHash, ok := item.GetLabels()[componentRouteHashLabelKey]
+		if !ok {
+			return fmt.Errorf("Unable to find expected componentRoute hash label")
+		}
+
+		if _, ok := existingHashes[expectedHash]; !ok {
+			log.Info("deleting CA bundle roleBinding", "name", item.GetName(), "namespace", item.GetNamespace())
+			if err := r.client.Delete(context.TODO(), &item); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			metrics.OrphanDeletedTotal.WithLabelValues("RoleBinding").Inc()
+			r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleBindingDeleted", "Deleted orphaned CA bundle roleBinding %s/%s", item.GetNamespace(), item.GetName())
+		}
+	}
+
+	caBundleConfigMapList := &corev1.ConfigMapList{}
+	r.cache.List(context.TODO(), caBundleConfigMapList, client.InNamespace(caBundleManagedNamespace), client.HasLabels{componentRouteHashLabelKey})
+	for _, item := range caBundleConfigMapList.Items {
+		expectedHash, ok := item.GetLabels()[componentRouteHashLabelKey]
+		if !ok {
+			return fmt.Errorf("Unable to find expected componentRoute hash label")
+		}
+
+		if _, ok := existingHashes[expectedHash]; !ok {
+			log.Info("deleting CA bundle configmap", "name", item.GetName(), "namespace", item.GetNamespace())
+			if err := r.client.Delete(context.TODO(), &item); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			metrics.OrphanDeletedTotal.WithLabelValues("ConfigMap").Inc()
+			r.recorder.Eventf(owner, corev1.EventTypeNormal, "CABundleDeleted", "Deleted orphaned CA bundle configmap %s/%s", item.GetNamespace(), item.GetName())
 		}
 	}
 
 	return nil
 }
 
-func (r *reconciler) ensureServiceCertKeyPairSecretRole(owner metav1.Object, componentRoute aggregatedComponentRoute) (string, error) {
+func (r *reconciler) ensureServiceCertKeyPairSecretRole(owner *configv1.Ingress, componentRoute aggregatedComponentRoute) (string, error) {
 	role := &rbacv1.Role{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: componentRoute.Name + "-",
@@ -261,6 +650,8 @@ func (r *reconciler) ensureServiceCertKeyPairSecretRole(owner metav1.Object, com
 		if err := r.client.Create(context.TODO(), role); err != nil {
 			return "", err
 		}
+		metrics.RoleCreatedTotal.Inc()
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleCreated", "Created role %s/%s for componentRoute %s", role.Namespace, role.GetName(), componentRoute.Name)
 		return role.GetName(), nil
 	}
 
@@ -277,19 +668,23 @@ func (r *reconciler) ensureServiceCertKeyPairSecretRole(owner metav1.Object, com
 	if err := r.client.Update(context.TODO(), &existingRole); err != nil {
 		return "", err
 	}
+	r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleUpdated", "Updated role %s/%s for componentRoute %s", existingRole.Namespace, existingRole.GetName(), componentRoute.Name)
 	return existingRole.GetName(), nil
 }
 
-func (r *reconciler) ensureServiceCertKeyPairSecretRoleBinding(owner metav1.Object, componentRoute aggregatedComponentRoute, roleName string) error {
-	subjects := []rbacv1.Subject{}
-	for _, serviceAccountName := range componentRoute.ConsumingUsers {
-		subjects = append(subjects, rbacv1.Subject{
-			Kind:     rbacv1.ServiceAccountKind,
-			Name:     serviceAccountName,
-			APIGroup: "",
-		})
+// ensureServiceCertKeyPairSecretRoleBinding grants componentRoute's
+// ConsumingUsers access to roleName. In Namespaced mode (the default) this
+// is a single RoleBinding in Config.SecretNamespace; in PerConsumerNamespace
+// mode it is one RoleBinding per distinct consumer namespace, each pointing
+// at the shared Role.
+func (r *reconciler) ensureServiceCertKeyPairSecretRoleBinding(owner *configv1.Ingress, componentRoute aggregatedComponentRoute, roleName string) error {
+	if r.config.BindingMode == PerConsumerNamespace {
+		return r.ensurePerConsumerNamespaceRoleBindings(owner, componentRoute, roleName)
 	}
+	return r.ensureNamespacedRoleBinding(owner, componentRoute, roleName)
+}
 
+func (r *reconciler) ensureNamespacedRoleBinding(owner *configv1.Ingress, componentRoute aggregatedComponentRoute, roleName string) error {
 	roleBinding := &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      roleName,
@@ -298,7 +693,7 @@ func (r *reconciler) ensureServiceCertKeyPairSecretRoleBinding(owner metav1.Obje
 				componentRouteHashLabelKey: componentRoute.Hash,
 			},
 		},
-		Subjects: subjects,
+		Subjects: componentRoute.Subjects,
 		RoleRef: rbacv1.RoleRef{
 			Kind:     "Role",
 			Name:     roleName,
@@ -307,11 +702,15 @@ func (r *reconciler) ensureServiceCertKeyPairSecretRoleBinding(owner metav1.Obje
 	}
 
 	roleBindingList := &rbacv1.RoleBindingList{}
-	if err := r.cache.List(context.TODO(), roleBindingList, componentRouteResources(componentRoute)...); err != nil {
+	if err := r.cache.List(context.TODO(), roleBindingList, componentRouteResourcesInNamespace(componentRoute, r.config.SecretNamespace)...); err != nil {
 		return err
 	}
 	if len(roleBindingList.Items) == 0 {
-		return r.client.Create(context.TODO(), roleBinding)
+		if err := r.client.Create(context.TODO(), roleBinding); err != nil {
+			return err
+		}
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleBindingCreated", "Created roleBinding %s/%s for componentRoute %s", roleBinding.Namespace, roleBinding.GetName(), componentRoute.Name)
+		return nil
 	}
 
 	for i, curRole := range roleBindingList.Items {
@@ -326,5 +725,68 @@ func (r *reconciler) ensureServiceCertKeyPairSecretRoleBinding(owner metav1.Obje
 	existingRoleBinding := roleBindingList.Items[0]
 	existingRoleBinding.Subjects = roleBinding.Subjects
 	existingRoleBinding.RoleRef = roleBinding.RoleRef
-	return r.client.Update(context.TODO(), &existingRoleBinding)
+	if err := r.client.Update(context.TODO(), &existingRoleBinding); err != nil {
+		return err
+	}
+	r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleBindingUpdated", "Updated roleBinding %s/%s for componentRoute %s", existingRoleBinding.Namespace, existingRoleBinding.GetName(), componentRoute.Name)
+	return nil
+}
+
+// ensurePerConsumerNamespaceRoleBindings groups componentRoute.Subjects by
+// the namespace they should bind in (a ServiceAccount's own namespace, or
+// Config.SecretNamespace for cluster-scoped Users and Groups) and ensures
+// one RoleBinding per namespace.
+func (r *reconciler) ensurePerConsumerNamespaceRoleBindings(owner *configv1.Ingress, componentRoute aggregatedComponentRoute, roleName string) error {
+	subjectsByNamespace := map[string][]rbacv1.Subject{}
+	for _, subject := range componentRoute.Subjects {
+		namespace := subjectNamespace(subject, r.config.SecretNamespace)
+		subjectsByNamespace[namespace] = append(subjectsByNamespace[namespace], subject)
+	}
+
+	for namespace, subjects := range subjectsByNamespace {
+		roleBinding := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      roleName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					componentRouteHashLabelKey: componentRoute.Hash,
+				},
+			},
+			Subjects: subjects,
+			RoleRef: rbacv1.RoleRef{
+				Kind:     "Role",
+				Name:     roleName,
+				APIGroup: "rbac.authorization.k8s.io",
+			},
+		}
+
+		roleBindingList := &rbacv1.RoleBindingList{}
+		if err := r.cache.List(context.TODO(), roleBindingList, componentRouteResourcesInNamespace(componentRoute, namespace)...); err != nil {
+			return err
+		}
+		if len(roleBindingList.Items) == 0 {
+			if err := r.client.Create(context.TODO(), roleBinding); err != nil {
+				return err
+			}
+			r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleBindingCreated", "Created roleBinding %s/%s for componentRoute %s", roleBinding.Namespace, roleBinding.GetName(), componentRoute.Name)
+			continue
+		}
+
+		for i, curRoleBinding := range roleBindingList.Items {
+			if i == 0 {
+				continue
+			}
+			if err := r.client.Delete(context.TODO(), &curRoleBinding); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+		existingRoleBinding := roleBindingList.Items[0]
+		existingRoleBinding.Subjects = roleBinding.Subjects
+		existingRoleBinding.RoleRef = roleBinding.RoleRef
+		if err := r.client.Update(context.TODO(), &existingRoleBinding); err != nil {
+			return err
+		}
+		r.recorder.Eventf(owner, corev1.EventTypeNormal, "RoleBindingUpdated", "Updated roleBinding %s/%s for componentRoute %s", existingRoleBinding.Namespace, existingRoleBinding.GetName(), componentRoute.Name)
+	}
+	return nil
 }
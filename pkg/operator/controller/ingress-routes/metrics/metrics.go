@@ -0,0 +1,53 @@
+// Package metrics declares the Prometheus collectors the ingressroutes
+// controller reports reconcile outcomes and RBAC churn through.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// ReconcileTotal counts reconciles of the cluster ingress config by
+	// outcome ("success" or "error").
+	ReconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingressroutes_reconcile_total",
+		Help: "Number of ingressroutes reconciles by result.",
+	}, []string{"result"})
+
+	// ReconcileDuration observes how long a single reconcile of the
+	// cluster ingress config takes.
+	ReconcileDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "ingressroutes_reconcile_duration_seconds",
+		Help:    "Time taken to reconcile the cluster ingress config's componentRoutes.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// RoleCreatedTotal counts Roles created for componentRoutes.
+	RoleCreatedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ingressroutes_role_created_total",
+		Help: "Number of Roles created for componentRoutes.",
+	})
+
+	// OrphanDeletedTotal counts resources deleted by cleanupOrphanedResources,
+	// by kind ("Role", "RoleBinding", or "Secret").
+	OrphanDeletedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingressroutes_orphan_deleted_total",
+		Help: "Number of orphaned resources deleted by kind.",
+	}, []string{"kind"})
+
+	// Active tracks which componentRoutes are currently reconciled, keyed by
+	// the componentRoute's namespace and name. hash is also carried so that
+	// cleanupOrphanedResources, which only has a deleted componentRoute's
+	// hash (read off the orphaned Role's label) to go on, can still find and
+	// clear the right series.
+	Active = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ingressroutes_active",
+		Help: "Whether a componentRoute is currently being reconciled (1) or not (0).",
+	}, []string{"namespace", "name", "hash"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(ReconcileTotal, ReconcileDuration, RoleCreatedTotal, OrphanDeletedTotal, Active)
+}
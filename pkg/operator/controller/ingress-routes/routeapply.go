@@ -0,0 +1,30 @@
+package ingressroutes
+
+// STATUS: BLOCKED. awgreene/cluster-ingress-operator#chunk1-4 asked for Route
+// materialization to move from read-modify-write to server-side apply with
+// this field manager. That reconciler doesn't exist in this checkout -- no
+// route.openshift.io/v1 Route type is vendored here, and no package writes a
+// Route at all -- so there is no read-modify-write loop to convert, and
+// nothing below is wired into a reconcile path. This file only reserves the
+// field manager name for whoever adds that reconciler. This item is not
+// done: do not close it against the backlog, and don't land a future commit
+// that claims it is until a Route reconciler actually exists to apply this
+// field manager with. Re-file it as the API-slice-only item it is, or hold
+// it until the Route type and an SSA-capable reconciler land.
+
+// componentRouteFieldManager is the field manager this package would use to
+// server-side-apply ComponentRouteSpec.Hostname/ServingCertKeyPairSecret onto
+// the underlying route.openshift.io/v1 Route (spec.host, spec.tls), so that
+// multiple operators co-owning the same Route each own only the fields they
+// apply, and removing a ComponentRouteSpec entry reverts the Route to its
+// operator-default host by relinquishing the managed fields rather than by a
+// read-modify-write that can stomp a concurrent writer.
+//
+// NOTE: this package only reconciles the RBAC/trust side of a ComponentRoute
+// (Roles, RoleBindings, mirrored secrets, CA bundles). Materializing
+// ComponentRouteSpec onto an actual Route object is a different reconciler's
+// job, and neither that reconciler nor the route.openshift.io/v1 Route type
+// it would apply exist in this checkout, so there is no read-modify-write
+// loop here to convert to server-side apply. This constant is left in place
+// for that reconciler to use once it exists.
+const componentRouteFieldManager = "ingress-operator/component-routes"
@@ -0,0 +1,20 @@
+package secretsync
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// NewComponentRouteSecretPredicate returns a predicate that matches secrets
+// labeled with componentRouteHashLabelKey, i.e. secrets this package has
+// already mirrored. It is used to re-trigger a reconcile of the cluster
+// ingress config when a mirrored secret is edited or deleted out from under
+// the controller.
+func NewComponentRouteSecretPredicate(componentRouteHashLabelKey string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
+		_, ok := meta.GetLabels()[componentRouteHashLabelKey]
+		return ok
+	})
+}
@@ -0,0 +1,93 @@
+// Package secretsync mirrors a componentRoute's servingCertKeyPairSecret
+// into the namespaces of its cross-namespace ConsumingUsers, so that an
+// operand's service account can read the secret with a same-namespace Role
+// and RoleBinding rather than needing access into openshift-config.
+package secretsync
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const controllerName = "ingressroutes_secretsync"
+
+var log = logf.Logger.WithName(controllerName)
+
+// SecretMirrorer copies a componentRoute's serving cert/key pair secret into
+// one or more consumer namespaces, keeping the mirrors labeled the same way
+// as the Role/RoleBinding generated for that componentRoute so they can be
+// cleaned up by the same orphan-sweep logic.
+type SecretMirrorer struct {
+	Client client.Client
+}
+
+// Mirror copies source into destNamespace under the same name, creating or
+// updating the copy as needed. labels is applied to the copy; callers pass
+// the componentRoute hash label, plus a hash of source's own name, so the
+// mirror can later be found and garbage-collected alongside the
+// Role/RoleBinding it supports, and distinguished from a stale mirror left
+// behind by a since-rotated or since-removed source secret.
+func (m *SecretMirrorer) Mirror(ctx context.Context, source *corev1.Secret, destNamespace string, labels map[string]string) error {
+	mirror := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      source.Name,
+			Namespace: destNamespace,
+			Labels:    labels,
+		},
+		Type: source.Type,
+		Data: source.Data,
+	}
+
+	existing := &corev1.Secret{}
+	err := m.Client.Get(ctx, types.NamespacedName{Namespace: destNamespace, Name: source.Name}, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		log.Info("mirroring secret", "name", source.Name, "namespace", destNamespace)
+		return m.Client.Create(ctx, mirror)
+	case err != nil:
+		return fmt.Errorf("failed to get existing mirror secret %s/%s: %v", destNamespace, source.Name, err)
+	}
+
+	existing.Type = source.Type
+	existing.Data = source.Data
+	existing.Labels = labels
+	return m.Client.Update(ctx, existing)
+}
+
+// ValidateServingCert PEM-decodes the tls.crt entry of secret, rejects
+// certificates that have already expired, and, when hostnames is non-empty,
+// rejects certificates whose SANs do not cover every entry.
+func ValidateServingCert(secret *corev1.Secret, hostnames []string) error {
+	pair, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("secret %s/%s is not a valid tls key pair: %v", secret.Namespace, secret.Name, err)
+	}
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("secret %s/%s does not contain a parseable certificate: %v", secret.Namespace, secret.Name, err)
+	}
+
+	if time.Now().After(cert.NotAfter) {
+		return fmt.Errorf("secret %s/%s certificate expired at %s", secret.Namespace, secret.Name, cert.NotAfter)
+	}
+
+	for _, hostname := range hostnames {
+		if err := cert.VerifyHostname(hostname); err != nil {
+			return fmt.Errorf("secret %s/%s certificate does not cover hostname %q: %v", secret.Namespace, secret.Name, hostname, err)
+		}
+	}
+	return nil
+}
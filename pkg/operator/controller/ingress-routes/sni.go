@@ -0,0 +1,118 @@
+package ingressroutes
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress-routes/secretsync"
+	routestatus "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress-routes/status"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// validateSNIMappings rejects an empty hostname on any mapping and duplicate
+// hostnames across mappings, both of which would make it impossible to tell
+// which servingCertKeyPairSecret a given SNI hostname should use.
+func validateSNIMappings(mappings []configv1.SNIMapping) error {
+	seen := map[string]struct{}{}
+	for _, mapping := range mappings {
+		if mapping.Hostname == "" {
+			return fmt.Errorf("sniMappings entries must specify a non-empty hostname")
+		}
+		if _, ok := seen[mapping.Hostname]; ok {
+			return fmt.Errorf("sniMappings contains duplicate hostname %q", mapping.Hostname)
+		}
+		seen[mapping.Hostname] = struct{}{}
+	}
+	return nil
+}
+
+// reconcileSNIMappings resolves, validates, and mirrors the servingCertKeyPairSecret
+// of every SNIMapping on componentRoute, in addition to the ComponentRoute's primary
+// servingCertKeyPairSecret. It returns the hostnames that are currently live (the
+// primary hostnames plus any SNIMapping hostname whose secret resolved and mirrored
+// successfully), the per-mapping Progressing/Degraded conditions to merge into the
+// ComponentRouteStatus, and a non-empty message if any mapping is degraded so the
+// caller can fold that into the overall Degraded condition.
+func (r *reconciler) reconcileSNIMappings(owner *configv1.Ingress, componentRoute aggregatedComponentRoute, now metav1.Time) ([]string, []configv1.ClusterOperatorStatusCondition, string) {
+	liveHostnames := append([]string{}, componentRoute.CurrentHostnames...)
+
+	if len(componentRoute.SNIMappings) == 0 {
+		return liveHostnames, nil, ""
+	}
+
+	if err := validateSNIMappings(componentRoute.SNIMappings); err != nil {
+		r.recorder.Eventf(owner, corev1.EventTypeWarning, "ValidationFailed", "invalid sniMappings for componentRoute %s: %v", componentRoute.Name, err)
+		return liveHostnames, nil, fmt.Sprintf("invalid sniMappings: %v", err)
+	}
+
+	var conditions []configv1.ClusterOperatorStatusCondition
+	var degradedMessages []string
+	for _, mapping := range componentRoute.SNIMappings {
+		progressingType := routestatus.SNIMappingProgressingConditionType(mapping.Hostname)
+		degradedType := routestatus.SNIMappingDegradedConditionType(mapping.Hostname)
+
+		sniSecret := &corev1.Secret{}
+		secretErr := r.cache.Get(context.TODO(), client.ObjectKey{Namespace: r.config.SecretNamespace, Name: mapping.ServingCertKeyPairSecret.Name}, sniSecret)
+		if secretErr != nil {
+			message := fmt.Sprintf("servingCertKeyPairSecret %q not found for sniMapping %q: %v", mapping.ServingCertKeyPairSecret.Name, mapping.Hostname, secretErr)
+			conditions = append(conditions, sniMappingCondition(progressingType, configv1.ConditionFalse, "SecretNotFound", message, now))
+			conditions = append(conditions, sniMappingCondition(degradedType, configv1.ConditionTrue, "SecretNotFound", message, now))
+			degradedMessages = append(degradedMessages, message)
+			r.recorder.Eventf(owner, corev1.EventTypeWarning, "ValidationFailed", "%s", message)
+			continue
+		}
+
+		if err := secretsync.ValidateServingCert(sniSecret, []string{mapping.Hostname}); err != nil {
+			message := fmt.Sprintf("servingCertKeyPairSecret %q invalid for sniMapping %q: %v", mapping.ServingCertKeyPairSecret.Name, mapping.Hostname, err)
+			conditions = append(conditions, sniMappingCondition(progressingType, configv1.ConditionFalse, "InvalidCertificate", message, now))
+			conditions = append(conditions, sniMappingCondition(degradedType, configv1.ConditionTrue, "InvalidCertificate", message, now))
+			degradedMessages = append(degradedMessages, message)
+			r.recorder.Eventf(owner, corev1.EventTypeWarning, "ValidationFailed", "%s", message)
+			continue
+		}
+
+		sniComponentRoute := componentRoute
+		sniComponentRoute.CurrentHostnames = []string{mapping.Hostname}
+		if err := r.mirrorToConsumerNamespaces(sniSecret, sniComponentRoute); err != nil {
+			message := fmt.Sprintf("failed to mirror sniMapping %q servingCertKeyPairSecret: %v", mapping.Hostname, err)
+			conditions = append(conditions, sniMappingCondition(progressingType, configv1.ConditionFalse, "MirrorFailed", message, now))
+			conditions = append(conditions, sniMappingCondition(degradedType, configv1.ConditionTrue, "MirrorFailed", message, now))
+			degradedMessages = append(degradedMessages, message)
+			r.recorder.Eventf(owner, corev1.EventTypeWarning, "ValidationFailed", "%s", message)
+			continue
+		}
+
+		conditions = append(conditions, sniMappingCondition(progressingType, configv1.ConditionFalse, "AsExpected", "sniMapping is live", now))
+		conditions = append(conditions, sniMappingCondition(degradedType, configv1.ConditionFalse, "AsExpected", "sniMapping is live", now))
+		liveHostnames = append(liveHostnames, mapping.Hostname)
+	}
+
+	return liveHostnames, conditions, joinMessages(degradedMessages)
+}
+
+func sniMappingCondition(conditionType string, status configv1.ConditionStatus, reason, message string, now metav1.Time) configv1.ClusterOperatorStatusCondition {
+	return configv1.ClusterOperatorStatusCondition{
+		Type:               configv1.ClusterStatusConditionType(conditionType),
+		Status:             status,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+func joinMessages(messages []string) string {
+	result := ""
+	for i, message := range messages {
+		if i > 0 {
+			result += "; "
+		}
+		result += message
+	}
+	return result
+}
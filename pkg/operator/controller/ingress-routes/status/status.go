@@ -0,0 +1,146 @@
+// Package status provides helpers for merging per-ComponentRoute conditions
+// into an Ingress's status and for aggregating those conditions into a
+// top-level ClusterOperator-style condition.
+package status
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types written to ComponentRouteStatus.Conditions by the
+// ingressroutes reconciler.
+const (
+	// RoleReadyCondition reports whether the Role granting read access to
+	// the componentRoute's serving cert secret exists and is up to date.
+	RoleReadyCondition = "RoleReady"
+	// RoleBindingReadyCondition reports whether the RoleBinding granting the
+	// componentRoute's ConsumingUsers access to the Role exists and is up
+	// to date.
+	RoleBindingReadyCondition = "RoleBindingReady"
+	// SecretResolvedCondition reports whether ServingCertKeyPairSecret
+	// refers to a secret that exists.
+	SecretResolvedCondition = "SecretResolved"
+	// DegradedCondition aggregates the above: it is True whenever any of
+	// them could not be satisfied.
+	DegradedCondition = "Degraded"
+	// TrustDistributedCondition reports whether the componentRoute's CA
+	// bundle configmap has been published and every ConsumingUsers service
+	// account has been granted get/watch on it.
+	TrustDistributedCondition = "TrustDistributed"
+)
+
+// SNIMappingProgressingConditionType returns the condition type used to
+// report whether the per-hostname secret for an SNIMapping has been resolved
+// and mirrored. One such condition is written per SNIMapping hostname, since
+// a single ComponentRoute can have several independently-failing mappings.
+func SNIMappingProgressingConditionType(hostname string) string {
+	return "SNIMappingProgressing/" + hostname
+}
+
+// SNIMappingDegradedConditionType returns the condition type used to report
+// whether an SNIMapping is currently degraded, analogous to
+// SNIMappingProgressingConditionType.
+func SNIMappingDegradedConditionType(hostname string) string {
+	return "SNIMappingDegraded/" + hostname
+}
+
+// ClientCertRotatedCondition is written to
+// IngressMetricsTLSStatus.Conditions. It is True as soon as the
+// router-metrics-client-certs secret holds a client certificate issued or
+// re-issued for the current rotation, and False if the most recent rotation
+// attempt failed.
+const ClientCertRotatedCondition = "ClientCertRotated"
+
+// IngressRoutesReadyCondition is the top-level, ClusterOperator-style
+// condition the operator's ClusterOperator object should carry: the logical
+// AND of every ComponentRoute's Degraded condition, computed by
+// AggregateDegraded below.
+//
+// Nothing in this checkout actually writes it onto a ClusterOperator object
+// yet: there's no vendored configv1.ClusterOperator type here and no client
+// wiring to fetch/update one, so AggregateDegraded is unused dead code for
+// now. It's left in place, tested as a pure function, for whoever adds that
+// wiring.
+const IngressRoutesReadyCondition = "IngressRoutesReady"
+
+// SetCondition sets the condition with the given type on conditions,
+// replacing any existing condition of the same type, and returns the
+// updated slice. LastTransitionTime is only bumped when Status actually
+// changes, matching the convention used throughout OpenShift operators.
+func SetCondition(conditions []configv1.ClusterOperatorStatusCondition, condition configv1.ClusterOperatorStatusCondition, now metav1.Time) []configv1.ClusterOperatorStatusCondition {
+	existing := FindCondition(conditions, condition.Type)
+	if existing == nil {
+		condition.LastTransitionTime = now
+		return append(conditions, condition)
+	}
+
+	if existing.Status != condition.Status {
+		existing.LastTransitionTime = now
+	}
+	existing.Status = condition.Status
+	existing.Reason = condition.Reason
+	existing.Message = condition.Message
+	return conditions
+}
+
+// FindCondition returns a pointer to the condition of the given type in
+// conditions, or nil if no such condition exists.
+func FindCondition(conditions []configv1.ClusterOperatorStatusCondition, conditionType string) *configv1.ClusterOperatorStatusCondition {
+	for i := range conditions {
+		if string(conditions[i].Type) == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// IsConditionTrue returns true if conditions has a condition of the given
+// type whose Status is ConditionTrue.
+func IsConditionTrue(conditions []configv1.ClusterOperatorStatusCondition, conditionType string) bool {
+	condition := FindCondition(conditions, conditionType)
+	return condition != nil && condition.Status == configv1.ConditionTrue
+}
+
+// AggregateDegraded computes the operator-wide IngressRoutesReadyCondition
+// from the Degraded condition of every componentRoute's status. The
+// aggregate is Degraded (ConditionFalse) if any componentRoute is Degraded,
+// and Ready (ConditionTrue) otherwise.
+func AggregateDegraded(componentRoutes []configv1.ComponentRouteStatus, now metav1.Time) configv1.ClusterOperatorStatusCondition {
+	var degraded []string
+	for _, route := range componentRoutes {
+		if IsConditionTrue(route.Conditions, DegradedCondition) {
+			degraded = append(degraded, route.Namespace+"/"+route.Name)
+		}
+	}
+
+	if len(degraded) == 0 {
+		return configv1.ClusterOperatorStatusCondition{
+			Type:               IngressRoutesReadyCondition,
+			Status:             configv1.ConditionTrue,
+			LastTransitionTime: now,
+			Reason:             "AsExpected",
+			Message:            "All componentRoutes are reconciled successfully",
+		}
+	}
+
+	return configv1.ClusterOperatorStatusCondition{
+		Type:               IngressRoutesReadyCondition,
+		Status:             configv1.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             "ComponentRoutesDegraded",
+		Message:            "componentRoutes degraded: " + joinNames(degraded),
+	}
+}
+
+func joinNames(names []string) string {
+	result := ""
+	for i, name := range names {
+		if i > 0 {
+			result += ", "
+		}
+		result += name
+	}
+	return result
+}
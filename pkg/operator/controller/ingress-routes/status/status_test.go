@@ -0,0 +1,74 @@
+package status
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAggregateDegraded(t *testing.T) {
+	now := metav1.Now()
+
+	tests := []struct {
+		name             string
+		componentRoutes  []configv1.ComponentRouteStatus
+		expectedStatus   configv1.ConditionStatus
+		expectedContains string
+	}{
+		{
+			name:             "no componentRoutes",
+			componentRoutes:  nil,
+			expectedStatus:   configv1.ConditionTrue,
+			expectedContains: "reconciled successfully",
+		},
+		{
+			name: "all componentRoutes healthy",
+			componentRoutes: []configv1.ComponentRouteStatus{
+				{Namespace: "openshift-authentication", Name: "oauth-openshift", Conditions: []configv1.ClusterOperatorStatusCondition{
+					{Type: DegradedCondition, Status: configv1.ConditionFalse},
+				}},
+			},
+			expectedStatus:   configv1.ConditionTrue,
+			expectedContains: "reconciled successfully",
+		},
+		{
+			name: "one componentRoute degraded",
+			componentRoutes: []configv1.ComponentRouteStatus{
+				{Namespace: "openshift-authentication", Name: "oauth-openshift", Conditions: []configv1.ClusterOperatorStatusCondition{
+					{Type: DegradedCondition, Status: configv1.ConditionFalse},
+				}},
+				{Namespace: "openshift-console", Name: "console", Conditions: []configv1.ClusterOperatorStatusCondition{
+					{Type: DegradedCondition, Status: configv1.ConditionTrue},
+				}},
+			},
+			expectedStatus:   configv1.ConditionFalse,
+			expectedContains: "openshift-console/console",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			condition := AggregateDegraded(test.componentRoutes, now)
+			if condition.Type != IngressRoutesReadyCondition {
+				t.Errorf("expected condition type %q, got %q", IngressRoutesReadyCondition, condition.Type)
+			}
+			if condition.Status != test.expectedStatus {
+				t.Errorf("expected status %q, got %q", test.expectedStatus, condition.Status)
+			}
+			if !contains(condition.Message, test.expectedContains) {
+				t.Errorf("expected message to contain %q, got %q", test.expectedContains, condition.Message)
+			}
+		})
+	}
+}
+
+func contains(s, substr string) bool {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if s[i:i+len(substr)] == substr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package ingressroutes
+
+import (
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+const (
+	serviceAccountPrefix = "system:serviceaccount:"
+	groupPrefix          = "system:group:"
+)
+
+// parseConsumingUser parses a single ComponentRouteStatus.ConsumingUsers
+// entry into a typed rbacv1.Subject. Three forms are recognized:
+//
+//	system:serviceaccount:<namespace>:<name>  -> ServiceAccount in <namespace>
+//	system:group:<name>                       -> Group
+//	<name>                                    -> User
+//
+// defaultNamespace is only used as a fallback and is currently unused by any
+// recognized form, but is accepted so callers don't need a special case for
+// malformed ServiceAccount references.
+func parseConsumingUser(consumingUser, defaultNamespace string) rbacv1.Subject {
+	if rest := strings.TrimPrefix(consumingUser, serviceAccountPrefix); rest != consumingUser {
+		if namespace, name, ok := splitOnce(rest, ':'); ok && namespace != "" && name != "" {
+			return rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: namespace, Name: name}
+		}
+		// Malformed "system:serviceaccount:" reference; fall back to
+		// treating the remainder as a same-namespace ServiceAccount name
+		// rather than dropping it silently.
+		return rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: defaultNamespace, Name: rest}
+	}
+
+	if name := strings.TrimPrefix(consumingUser, groupPrefix); name != consumingUser {
+		return rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: name}
+	}
+
+	return rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: consumingUser}
+}
+
+// splitOnce splits s on the first occurrence of sep into (before, after),
+// returning ok=false if sep doesn't appear in s.
+func splitOnce(s string, sep byte) (string, string, bool) {
+	i := strings.IndexByte(s, sep)
+	if i < 0 {
+		return "", "", false
+	}
+	return s[:i], s[i+1:], true
+}
+
+// subjectNamespace returns the namespace a Subject should be considered to
+// live in for the purposes of RoleBinding placement: a ServiceAccount's own
+// namespace, or defaultNamespace for cluster-scoped Users and Groups.
+func subjectNamespace(subject rbacv1.Subject, defaultNamespace string) string {
+	if subject.Kind == rbacv1.ServiceAccountKind && subject.Namespace != "" {
+		return subject.Namespace
+	}
+	return defaultNamespace
+}
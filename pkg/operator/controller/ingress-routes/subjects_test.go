@@ -0,0 +1,87 @@
+package ingressroutes
+
+import (
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestParseConsumingUser(t *testing.T) {
+	tests := []struct {
+		name             string
+		consumingUser    string
+		defaultNamespace string
+		expected         rbacv1.Subject
+	}{
+		{
+			name:             "service account with explicit namespace",
+			consumingUser:    "system:serviceaccount:openshift-authentication:oauth-openshift",
+			defaultNamespace: "openshift-config",
+			expected:         rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "openshift-authentication", Name: "oauth-openshift"},
+		},
+		{
+			name:             "group",
+			consumingUser:    "system:group:cluster-admins",
+			defaultNamespace: "openshift-config",
+			expected:         rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "cluster-admins"},
+		},
+		{
+			name:             "plain user name",
+			consumingUser:    "jdoe",
+			defaultNamespace: "openshift-config",
+			expected:         rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "jdoe"},
+		},
+		{
+			name:             "malformed service account reference falls back to default namespace",
+			consumingUser:    "system:serviceaccount:no-colon-name",
+			defaultNamespace: "openshift-config",
+			expected:         rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "openshift-config", Name: "no-colon-name"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := parseConsumingUser(test.consumingUser, test.defaultNamespace)
+			if actual != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSubjectNamespace(t *testing.T) {
+	tests := []struct {
+		name             string
+		subject          rbacv1.Subject
+		defaultNamespace string
+		expected         string
+	}{
+		{
+			name:             "service account with namespace",
+			subject:          rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "openshift-console", Name: "console"},
+			defaultNamespace: "openshift-config",
+			expected:         "openshift-console",
+		},
+		{
+			name:             "group falls back to default namespace",
+			subject:          rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "cluster-admins"},
+			defaultNamespace: "openshift-config",
+			expected:         "openshift-config",
+		},
+		{
+			name:             "user falls back to default namespace",
+			subject:          rbacv1.Subject{Kind: rbacv1.UserKind, Name: "jdoe"},
+			defaultNamespace: "openshift-config",
+			expected:         "openshift-config",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := subjectNamespace(test.subject, test.defaultNamespace)
+			if actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
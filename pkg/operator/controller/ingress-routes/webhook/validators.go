@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"regexp"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HostnameConflictValidator rejects ComponentRoutes whose requested hostname
+// is, or falls under, the platform's default ingress domain. A route cannot
+// simultaneously be served by the default wildcard certificate and a
+// customized hostname/cert pair.
+type HostnameConflictValidator struct {
+	// IngressDomain is the cluster's default ingress domain (Ingress.Spec.Domain).
+	IngressDomain string
+}
+
+func (v *HostnameConflictValidator) Name() string { return "HostnameConflict" }
+
+func (v *HostnameConflictValidator) Validate(ctx context.Context, old, updated *configv1.Ingress) error {
+	for _, route := range updated.Spec.ComponentRoutes {
+		if route.Hostname == "" {
+			continue
+		}
+		if route.Hostname == v.IngressDomain || strings.HasSuffix(route.Hostname, "."+v.IngressDomain) {
+			return fmt.Errorf("componentRoute %s/%s hostname %q conflicts with the platform default domain %q", route.Namespace, route.Name, route.Hostname, v.IngressDomain)
+		}
+	}
+	return nil
+}
+
+// DuplicateComponentRouteValidator rejects specs that name the same
+// namespace/name ComponentRoute more than once. The reconciler keys
+// generated Roles and RoleBindings by that pair, so duplicates would
+// silently collapse into a single set of RBAC resources.
+type DuplicateComponentRouteValidator struct{}
+
+func (v *DuplicateComponentRouteValidator) Name() string { return "DuplicateComponentRoute" }
+
+func (v *DuplicateComponentRouteValidator) Validate(ctx context.Context, old, updated *configv1.Ingress) error {
+	seen := map[string]struct{}{}
+	for _, route := range updated.Spec.ComponentRoutes {
+		key := route.Namespace + "/" + route.Name
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate componentRoute %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// ServingCertValidator rejects ComponentRoutes whose ServingCertKeyPairSecret
+// does not exist, is not a kubernetes.io/tls secret, or whose certificate's
+// SANs do not cover the requested hostname.
+type ServingCertValidator struct {
+	Client client.Client
+	// SecretNamespace is the namespace ServingCertKeyPairSecret is resolved
+	// against, matching ingressroutes.Config.SecretNamespace.
+	SecretNamespace string
+}
+
+func (v *ServingCertValidator) Name() string { return "ServingCert" }
+
+func (v *ServingCertValidator) Validate(ctx context.Context, old, updated *configv1.Ingress) error {
+	for _, route := range updated.Spec.ComponentRoutes {
+		secretName := route.ServingCertKeyPairSecret.Name
+		if secretName == "" {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := v.Client.Get(ctx, types.NamespacedName{Namespace: v.SecretNamespace, Name: secretName}, secret); err != nil {
+			return fmt.Errorf("componentRoute %s/%s references secret %q which does not exist: %v", route.Namespace, route.Name, secretName, err)
+		}
+		if secret.Type != corev1.SecretTypeTLS {
+			return fmt.Errorf("componentRoute %s/%s references secret %q which is not a %s secret", route.Namespace, route.Name, secretName, corev1.SecretTypeTLS)
+		}
+		if route.Hostname == "" {
+			continue
+		}
+
+		pair, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return fmt.Errorf("componentRoute %s/%s secret %q is not a valid tls key pair: %v", route.Namespace, route.Name, secretName, err)
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			return fmt.Errorf("componentRoute %s/%s secret %q does not contain a parseable certificate: %v", route.Namespace, route.Name, secretName, err)
+		}
+		if err := cert.VerifyHostname(route.Hostname); err != nil {
+			return fmt.Errorf("componentRoute %s/%s secret %q certificate does not cover hostname %q: %v", route.Namespace, route.Name, secretName, route.Hostname, err)
+		}
+	}
+	return nil
+}
+
+var eipAllocationPattern = regexp.MustCompile(`^eipalloc-[0-9a-f]{8,17}$`)
+
+// AWSLoadBalancerInfo reports the facts about the default ingresscontroller's
+// load balancer that EIPAllocationValidator needs: how many availability
+// zones it spans, and whether its type is actually NLB (EIPAllocations only
+// make sense for an NLB). It is a seam so this validator doesn't need to
+// import the ingresscontroller or Infrastructure status packages directly.
+type AWSLoadBalancerInfo interface {
+	AvailabilityZoneCount() (int, error)
+	IsNetworkLoadBalancer() (bool, error)
+}
+
+// EIPAllocationValidator rejects Spec.AWS.EIPAllocations that are malformed,
+// contain duplicates, don't have exactly one allocation per availability
+// zone, or are set while the default ingresscontroller's load balancer isn't
+// an NLB.
+type EIPAllocationValidator struct {
+	LoadBalancer AWSLoadBalancerInfo
+}
+
+func (v *EIPAllocationValidator) Name() string { return "EIPAllocation" }
+
+func (v *EIPAllocationValidator) Validate(ctx context.Context, old, updated *configv1.Ingress) error {
+	if updated.Spec.AWS == nil || len(updated.Spec.AWS.EIPAllocations) == 0 {
+		return nil
+	}
+
+	seen := map[configv1.EIPAllocation]struct{}{}
+	for _, allocation := range updated.Spec.AWS.EIPAllocations {
+		if !eipAllocationPattern.MatchString(string(allocation)) {
+			return fmt.Errorf("eipAllocation %q is not a valid AWS EIP allocation ID", allocation)
+		}
+		if _, ok := seen[allocation]; ok {
+			return fmt.Errorf("duplicate eipAllocation %q", allocation)
+		}
+		seen[allocation] = struct{}{}
+	}
+
+	isNLB, err := v.LoadBalancer.IsNetworkLoadBalancer()
+	if err != nil {
+		return fmt.Errorf("failed to determine default ingresscontroller load balancer type: %v", err)
+	}
+	if !isNLB {
+		return fmt.Errorf("eipAllocations can only be set while the default ingresscontroller's load balancer type is NLB")
+	}
+
+	azCount, err := v.LoadBalancer.AvailabilityZoneCount()
+	if err != nil {
+		return fmt.Errorf("failed to determine availability zone count: %v", err)
+	}
+	if len(updated.Spec.AWS.EIPAllocations) != azCount {
+		return fmt.Errorf("eipAllocations has %d entries but the load balancer spans %d availability zones; exactly one allocation per zone is required", len(updated.Spec.AWS.EIPAllocations), azCount)
+	}
+
+	return nil
+}
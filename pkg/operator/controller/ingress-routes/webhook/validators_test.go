@@ -0,0 +1,250 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestHostnameConflictValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		hostname  string
+		expectErr bool
+	}{
+		{name: "empty hostname is allowed", hostname: "", expectErr: false},
+		{name: "unrelated hostname is allowed", hostname: "console.example.com", expectErr: false},
+		{name: "exact domain match is rejected", hostname: "apps.example.com", expectErr: true},
+		{name: "subdomain of default domain is rejected", hostname: "oauth.apps.example.com", expectErr: true},
+	}
+
+	v := &HostnameConflictValidator{IngressDomain: "apps.example.com"}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			updated := &configv1.Ingress{Spec: configv1.IngressSpec{ComponentRoutes: []configv1.ComponentRouteSpec{
+				{Namespace: "openshift-authentication", Name: "oauth-openshift", Hostname: test.hostname},
+			}}}
+			err := v.Validate(context.TODO(), &configv1.Ingress{}, updated)
+			if test.expectErr && err == nil {
+				t.Errorf("expected an error, got nil")
+			}
+			if !test.expectErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDuplicateComponentRouteValidator(t *testing.T) {
+	v := &DuplicateComponentRouteValidator{}
+
+	unique := &configv1.Ingress{Spec: configv1.IngressSpec{ComponentRoutes: []configv1.ComponentRouteSpec{
+		{Namespace: "openshift-authentication", Name: "oauth-openshift"},
+		{Namespace: "openshift-console", Name: "console"},
+	}}}
+	if err := v.Validate(context.TODO(), &configv1.Ingress{}, unique); err != nil {
+		t.Errorf("expected no error for unique componentRoutes, got %v", err)
+	}
+
+	duplicate := &configv1.Ingress{Spec: configv1.IngressSpec{ComponentRoutes: []configv1.ComponentRouteSpec{
+		{Namespace: "openshift-authentication", Name: "oauth-openshift"},
+		{Namespace: "openshift-authentication", Name: "oauth-openshift"},
+	}}}
+	if err := v.Validate(context.TODO(), &configv1.Ingress{}, duplicate); err == nil {
+		t.Errorf("expected an error for duplicate componentRoutes, got nil")
+	}
+}
+
+func TestServingCertValidator(t *testing.T) {
+	validData := selfSignedTLSSecretData(t, "oauth.apps.example.com")
+
+	tests := []struct {
+		name      string
+		secret    *corev1.Secret
+		hostname  string
+		expectErr bool
+	}{
+		{
+			name: "valid tls secret matching hostname",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-secret", Namespace: "openshift-config"},
+				Type:       corev1.SecretTypeTLS,
+				Data:       validData,
+			},
+			hostname:  "oauth.apps.example.com",
+			expectErr: false,
+		},
+		{
+			name: "tls secret not matching hostname",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-secret", Namespace: "openshift-config"},
+				Type:       corev1.SecretTypeTLS,
+				Data:       validData,
+			},
+			hostname:  "console.apps.example.com",
+			expectErr: true,
+		},
+		{
+			name: "secret is not a tls secret",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-secret", Namespace: "openshift-config"},
+				Type:       corev1.SecretTypeOpaque,
+			},
+			hostname:  "oauth.apps.example.com",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &ServingCertValidator{
+				Client:          fake.NewFakeClient(test.secret),
+				SecretNamespace: "openshift-config",
+			}
+			updated := &configv1.Ingress{Spec: configv1.IngressSpec{ComponentRoutes: []configv1.ComponentRouteSpec{
+				{
+					Namespace:                "openshift-authentication",
+					Name:                     "oauth-openshift",
+					Hostname:                 test.hostname,
+					ServingCertKeyPairSecret: configv1.SecretNameReference{Name: "cert-secret"},
+				},
+			}}}
+			err := v.Validate(context.TODO(), &configv1.Ingress{}, updated)
+			if test.expectErr && err == nil {
+				t.Errorf("expected an error, got nil")
+			}
+			if !test.expectErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+
+	t.Run("referenced secret does not exist", func(t *testing.T) {
+		v := &ServingCertValidator{Client: fake.NewFakeClient(), SecretNamespace: "openshift-config"}
+		updated := &configv1.Ingress{Spec: configv1.IngressSpec{ComponentRoutes: []configv1.ComponentRouteSpec{
+			{Namespace: "openshift-authentication", Name: "oauth-openshift", ServingCertKeyPairSecret: configv1.SecretNameReference{Name: "missing"}},
+		}}}
+		if err := v.Validate(context.TODO(), &configv1.Ingress{}, updated); err == nil {
+			t.Errorf("expected an error, got nil")
+		}
+	})
+}
+
+type fakeAWSLoadBalancerInfo struct {
+	isNLB            bool
+	isNLBErr         error
+	availabilityZone int
+	azErr            error
+}
+
+func (f *fakeAWSLoadBalancerInfo) IsNetworkLoadBalancer() (bool, error) { return f.isNLB, f.isNLBErr }
+func (f *fakeAWSLoadBalancerInfo) AvailabilityZoneCount() (int, error) {
+	return f.availabilityZone, f.azErr
+}
+
+func TestEIPAllocationValidator(t *testing.T) {
+	tests := []struct {
+		name         string
+		allocations  []configv1.EIPAllocation
+		loadBalancer *fakeAWSLoadBalancerInfo
+		expectErr    bool
+	}{
+		{
+			name:         "no allocations is allowed regardless of load balancer",
+			allocations:  nil,
+			loadBalancer: &fakeAWSLoadBalancerInfo{isNLB: false},
+			expectErr:    false,
+		},
+		{
+			name:         "matching one allocation per AZ on an NLB is allowed",
+			allocations:  []configv1.EIPAllocation{"eipalloc-0123456789abcdef0", "eipalloc-abcdef0123456789a"},
+			loadBalancer: &fakeAWSLoadBalancerInfo{isNLB: true, availabilityZone: 2},
+			expectErr:    false,
+		},
+		{
+			name:         "malformed allocation id is rejected",
+			allocations:  []configv1.EIPAllocation{"not-an-eip-alloc-id"},
+			loadBalancer: &fakeAWSLoadBalancerInfo{isNLB: true, availabilityZone: 1},
+			expectErr:    true,
+		},
+		{
+			name:         "duplicate allocation ids are rejected",
+			allocations:  []configv1.EIPAllocation{"eipalloc-0123456789abcdef0", "eipalloc-0123456789abcdef0"},
+			loadBalancer: &fakeAWSLoadBalancerInfo{isNLB: true, availabilityZone: 2},
+			expectErr:    true,
+		},
+		{
+			name:         "non-NLB load balancer is rejected",
+			allocations:  []configv1.EIPAllocation{"eipalloc-0123456789abcdef0"},
+			loadBalancer: &fakeAWSLoadBalancerInfo{isNLB: false},
+			expectErr:    true,
+		},
+		{
+			name:         "allocation count not matching AZ count is rejected",
+			allocations:  []configv1.EIPAllocation{"eipalloc-0123456789abcdef0"},
+			loadBalancer: &fakeAWSLoadBalancerInfo{isNLB: true, availabilityZone: 2},
+			expectErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &EIPAllocationValidator{LoadBalancer: test.loadBalancer}
+			updated := &configv1.Ingress{Spec: configv1.IngressSpec{AWS: &configv1.AWSIngressSpec{EIPAllocations: test.allocations}}}
+			err := v.Validate(context.TODO(), &configv1.Ingress{}, updated)
+			if test.expectErr && err == nil {
+				t.Errorf("expected an error, got nil")
+			}
+			if !test.expectErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+// selfSignedTLSSecretData generates a fresh self-signed tls.crt/tls.key pair
+// whose SAN covers hostname, mirroring the e2e suite's helper of the same
+// name so ServingCertValidator's SAN-matching path can be exercised here
+// without depending on an external CA.
+func selfSignedTLSSecretData(t *testing.T, hostname string) map[string][]byte {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: hostname},
+		DNSNames:     []string{hostname},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return map[string][]byte{
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+	}
+}
@@ -0,0 +1,95 @@
+// Package webhook implements a validating admission webhook for the
+// configv1.Ingress resource. It rejects changes to Spec.ComponentRoutes that
+// the ingressroutes reconciler would otherwise silently drop or misapply,
+// such as a hostname that conflicts with the platform default, a reference to
+// a serving cert secret that doesn't exist, or a duplicate namespace/name
+// pair.
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// webhookPath is the path the ValidatingWebhookConfiguration manifest points
+// the apiserver at for the Ingress resource.
+const webhookPath = "/validate-config-openshift-io-v1-ingress"
+
+const controllerName = "ingressroutes_validating_webhook"
+
+var log = logf.Logger.WithName(controllerName)
+
+// Validator rejects changes to the cluster Ingress config. Additional rules
+// can be plugged into Handler by passing more Validators to New.
+type Validator interface {
+	// Name identifies the validator in log messages and admission responses.
+	Name() string
+	// Validate inspects the incoming Ingress (and, if this is an update, the
+	// object it would replace) and returns an error describing why the
+	// change must be rejected, or nil if the change is allowed.
+	Validate(ctx context.Context, old, updated *configv1.Ingress) error
+}
+
+// Handler is an admission.Handler that denies updates to the cluster Ingress
+// config when any registered Validator objects to the change.
+//
+// +k8s:deepcopy-gen=false
+type Handler struct {
+	validators []Validator
+	decoder    *admission.Decoder
+}
+
+// New returns a Handler that runs validators, in order, against every
+// update to the cluster Ingress config. The first Validator to return an
+// error causes the request to be denied.
+func New(validators ...Validator) *Handler {
+	return &Handler{validators: validators}
+}
+
+// Handle implements admission.Handler.
+func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	updated := &configv1.Ingress{}
+	if err := h.decoder.DecodeRaw(req.Object, updated); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	old := &configv1.Ingress{}
+	if len(req.OldObject.Raw) > 0 {
+		if err := h.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	for _, v := range h.validators {
+		if err := v.Validate(ctx, old, updated); err != nil {
+			log.Info("rejecting ingress update", "validator", v.Name(), "reason", err.Error())
+			return admission.Denied(fmt.Sprintf("%s: %v", v.Name(), err))
+		}
+	}
+	return admission.Allowed("")
+}
+
+// InjectDecoder implements admission.DecoderInjector so the manager can wire
+// up a Decoder for us.
+func (h *Handler) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+// RegisterWithManager registers a Handler built from validators as the
+// manager's webhook server handler for webhookPath. The manager's webhook
+// server is expected to already be configured with TLS material from the
+// operator's service-cert (see manager.Options.{CertDir,Port}); this just
+// wires up the route and the validators that back it.
+func RegisterWithManager(mgr manager.Manager, validators ...Validator) {
+	mgr.GetWebhookServer().Register(webhookPath, &webhook.Admission{Handler: New(validators...)})
+}
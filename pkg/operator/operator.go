@@ -0,0 +1,37 @@
+// Package operator is expected to hold this operator's manager bootstrap:
+// leader election, controller registration, and webhook server
+// configuration. None of that exists yet in this checkout -- there is no
+// main.go and nothing else under pkg/operator -- so this file adds only the
+// piece the ingressroutes validating webhook needs: a call site that builds
+// its full set of validators and registers them against a manager's webhook
+// server. Whatever assembles this operator's manager should call
+// RegisterIngressRoutesWebhook alongside ingressroutes.New.
+package operator
+
+import (
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress-routes/webhook"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// RegisterIngressRoutesWebhook registers the ingressroutes validating
+// webhook's Handler, built from HostnameConflictValidator,
+// DuplicateComponentRouteValidator, and ServingCertValidator, against mgr's
+// webhook server. mgr's webhook server is expected to already be configured
+// with TLS material from the operator's service-cert (see
+// manager.Options.{CertDir,Port}).
+//
+// awsLoadBalancer backs EIPAllocationValidator and is only registered when
+// non-nil, since Spec.AWS.EIPAllocations is ignored on non-AWS platforms and
+// those platforms have no load balancer facts to report.
+func RegisterIngressRoutesWebhook(mgr manager.Manager, ingressDomain, secretNamespace string, awsLoadBalancer webhook.AWSLoadBalancerInfo) {
+	validators := []webhook.Validator{
+		&webhook.HostnameConflictValidator{IngressDomain: ingressDomain},
+		&webhook.DuplicateComponentRouteValidator{},
+		&webhook.ServingCertValidator{Client: mgr.GetClient(), SecretNamespace: secretNamespace},
+	}
+	if awsLoadBalancer != nil {
+		validators = append(validators, &webhook.EIPAllocationValidator{LoadBalancer: awsLoadBalancer})
+	}
+	webhook.RegisterWithManager(mgr, validators...)
+}
@@ -3,15 +3,24 @@
 package e2e
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
 	configv1 "github.com/openshift/api/config/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -275,3 +284,713 @@ func pollForValidComponentRouteRoleBinding(t *testing.T, componentRoute configv1
 
 	return err
 }
+
+// TestIngressConfigDegradedCondition tests that a componentRoute whose
+// servingCertKeyPairSecret names a nonexistent secret surfaces a Degraded
+// condition on the Ingress status within a bounded time.
+func TestIngressConfigDegradedCondition(t *testing.T) {
+	ingress := &configv1.Ingress{}
+	if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+		t.Fatalf("failed to get ingress resource: %v", err)
+	}
+
+	defer func() {
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+			t.Fatalf("failed to get ingress resource: %v", err)
+		}
+		ingress.Spec.ComponentRoutes = nil
+		if err := kclient.Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+		ingress.Status.ComponentRoutes = nil
+		if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+	}()
+
+	ingress.Spec.ComponentRoutes = []configv1.ComponentRouteSpec{
+		{
+			Namespace: "default",
+			Name:      "baz",
+			Hostname:  "www.testing.com",
+			ServingCertKeyPairSecret: configv1.SecretNameReference{
+				Name: "does-not-exist",
+			},
+		},
+	}
+	if err := kclient.Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress resource: %v", err)
+	}
+
+	ingress.Status = configv1.IngressStatus{
+		ComponentRoutes: []configv1.ComponentRouteStatus{
+			{
+				Namespace:        "default",
+				Name:             "baz",
+				ConsumingUsers:   []string{"baz"},
+				DefaultHostname:  "baz.com",
+				CurrentHostnames: []string{"baz.com"},
+			},
+		},
+	}
+	if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress status: %v", err)
+	}
+
+	if err := pollForDegradedCondition(t, "default", "baz"); err != nil {
+		t.Errorf("componentRoute did not become degraded: %v", err)
+	}
+}
+
+// TestIngressConfigSecretMirroring tests that a componentRoute's
+// servingCertKeyPairSecret is mirrored into a cross-namespace ConsumingUser's
+// namespace, and that rotating the source secret's contents is reflected in
+// the mirror.
+func TestIngressConfigSecretMirroring(t *testing.T) {
+	const consumerNamespace = "default"
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mirror-test",
+			Namespace: secretNamespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: selfSignedTLSSecretData(t, "mirror.testing.com"),
+	}
+	if err := kclient.Create(context.TODO(), secret); err != nil {
+		t.Fatalf("failed to create source secret: %v", err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), secret); err != nil {
+			t.Errorf("failed to delete source secret: %v", err)
+		}
+	}()
+
+	ingress := &configv1.Ingress{}
+	if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+		t.Fatalf("failed to get ingress resource: %v", err)
+	}
+
+	defer func() {
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+			t.Fatalf("failed to get ingress resource: %v", err)
+		}
+		ingress.Spec.ComponentRoutes = nil
+		if err := kclient.Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+		ingress.Status.ComponentRoutes = nil
+		if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+	}()
+
+	ingress.Spec.ComponentRoutes = []configv1.ComponentRouteSpec{
+		{
+			Namespace: "default",
+			Name:      "mirror",
+			Hostname:  "mirror.testing.com",
+			ServingCertKeyPairSecret: configv1.SecretNameReference{
+				Name: secret.Name,
+			},
+		},
+	}
+	if err := kclient.Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress resource: %v", err)
+	}
+
+	ingress.Status = configv1.IngressStatus{
+		ComponentRoutes: []configv1.ComponentRouteStatus{
+			{
+				Namespace:        "default",
+				Name:             "mirror",
+				ConsumingUsers:   []string{fmt.Sprintf("system:serviceaccount:%s:mirror-consumer", consumerNamespace)},
+				DefaultHostname:  "mirror.testing.com",
+				CurrentHostnames: []string{"mirror.testing.com"},
+			},
+		},
+	}
+	if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress status: %v", err)
+	}
+
+	if err := pollForMirroredSecretData(t, consumerNamespace, secret.Name, secret.Data); err != nil {
+		t.Fatalf("secret was not mirrored: %v", err)
+	}
+
+	// Rotate the source secret's contents and check that the mirror follows.
+	if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: secretNamespace, Name: secret.Name}, secret); err != nil {
+		t.Fatalf("failed to get source secret: %v", err)
+	}
+	secret.Data = selfSignedTLSSecretData(t, "mirror.testing.com")
+	if err := kclient.Update(context.TODO(), secret); err != nil {
+		t.Fatalf("failed to rotate source secret: %v", err)
+	}
+
+	if err := pollForMirroredSecretData(t, consumerNamespace, secret.Name, secret.Data); err != nil {
+		t.Fatalf("rotated secret was not mirrored: %v", err)
+	}
+}
+
+func pollForMirroredSecretData(t *testing.T, namespace, name string, data map[string][]byte) error {
+	mirror := &corev1.Secret{}
+	return wait.PollImmediate(1*time.Second, 30*time.Second, func() (bool, error) {
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, mirror); err != nil {
+			return false, nil
+		}
+		return bytes.Equal(mirror.Data[corev1.TLSCertKey], data[corev1.TLSCertKey]), nil
+	})
+}
+
+// selfSignedTLSSecretData generates a fresh self-signed tls.crt/tls.key pair
+// whose SAN covers hostname, suitable for exercising cert validation paths
+// in these tests without depending on an external CA.
+func selfSignedTLSSecretData(t *testing.T, hostname string) map[string][]byte {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: hostname},
+		DNSNames:     []string{hostname},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return map[string][]byte{
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+	}
+}
+
+// TestIngressConfigPerConsumerNamespaceBindingMode tests that, when the
+// operator is configured with BindingMode: PerConsumerNamespace, a
+// componentRoute with ConsumingUsers in two distinct namespaces gets a
+// RoleBinding in each of those namespaces rather than a single RoleBinding
+// in openshift-config.
+func TestIngressConfigPerConsumerNamespaceBindingMode(t *testing.T) {
+	ingress := &configv1.Ingress{}
+	if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+		t.Fatalf("failed to get ingress resource: %v", err)
+	}
+
+	defer func() {
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+			t.Fatalf("failed to get ingress resource: %v", err)
+		}
+		ingress.Spec.ComponentRoutes = nil
+		if err := kclient.Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+		ingress.Status.ComponentRoutes = nil
+		if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+	}()
+
+	ingress.Spec.ComponentRoutes = []configv1.ComponentRouteSpec{
+		{
+			Namespace: "default",
+			Name:      "multi-tenant",
+			Hostname:  "www.testing.com",
+			ServingCertKeyPairSecret: configv1.SecretNameReference{
+				Name: "multi-tenant",
+			},
+		},
+	}
+	if err := kclient.Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress resource: %v", err)
+	}
+
+	ingress.Status = configv1.IngressStatus{
+		ComponentRoutes: []configv1.ComponentRouteStatus{
+			{
+				Namespace: "default",
+				Name:      "multi-tenant",
+				ConsumingUsers: []string{
+					"system:serviceaccount:openshift-authentication:oauth-openshift",
+					"system:serviceaccount:openshift-console:console",
+				},
+				DefaultHostname:  "multi-tenant.com",
+				CurrentHostnames: []string{"multi-tenant.com"},
+			},
+		},
+	}
+	if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress status: %v", err)
+	}
+
+	for _, consumer := range []struct{ namespace, name string }{
+		{"openshift-authentication", "oauth-openshift"},
+		{"openshift-console", "console"},
+	} {
+		if err := pollForPerConsumerNamespaceRoleBinding(t, "default", "multi-tenant", consumer.namespace, consumer.name); err != nil {
+			t.Errorf("bad roleBinding in namespace %s: %v", consumer.namespace, err)
+		}
+	}
+}
+
+func pollForPerConsumerNamespaceRoleBinding(t *testing.T, crNamespace, crName, consumerNamespace, consumerName string) error {
+	listOptions := []client.ListOption{
+		client.MatchingLabels{
+			componentRouteHashLabelKey: util.Hash(fmt.Sprintf("%s/%s", crNamespace, crName)),
+		},
+		client.InNamespace(consumerNamespace),
+	}
+
+	roleBindingList := &rbacv1.RoleBindingList{}
+	return wait.PollImmediate(1*time.Second, 10*time.Second, func() (bool, error) {
+		if err := kclient.List(context.TODO(), roleBindingList, listOptions...); err != nil {
+			return false, nil
+		}
+		if len(roleBindingList.Items) != 1 {
+			return false, nil
+		}
+
+		roleBinding := roleBindingList.Items[0]
+		for _, subject := range roleBinding.Subjects {
+			if subject.Name == consumerName && subject.Namespace == consumerNamespace {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// TestIngressConfigCrossNamespaceServiceAccountSubject tests that a
+// ConsumingUsers entry of the form
+// "system:serviceaccount:<namespace>:<name>" produces a well-formed
+// ServiceAccount subject, preserving the referenced namespace rather than
+// dropping it the way the old plain-string handling did.
+func TestIngressConfigCrossNamespaceServiceAccountSubject(t *testing.T) {
+	ingress := &configv1.Ingress{}
+	if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+		t.Fatalf("failed to get ingress resource: %v", err)
+	}
+
+	defer func() {
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+			t.Fatalf("failed to get ingress resource: %v", err)
+		}
+		ingress.Spec.ComponentRoutes = nil
+		if err := kclient.Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+		ingress.Status.ComponentRoutes = nil
+		if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+	}()
+
+	ingress.Spec.ComponentRoutes = []configv1.ComponentRouteSpec{
+		{
+			Namespace: "default",
+			Name:      "oauth",
+			Hostname:  "oauth.testing.com",
+			ServingCertKeyPairSecret: configv1.SecretNameReference{
+				Name: "oauth",
+			},
+		},
+	}
+	if err := kclient.Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress resource: %v", err)
+	}
+
+	ingress.Status = configv1.IngressStatus{
+		ComponentRoutes: []configv1.ComponentRouteStatus{
+			{
+				Namespace:        "default",
+				Name:             "oauth",
+				ConsumingUsers:   []string{"system:serviceaccount:openshift-authentication:oauth-openshift"},
+				DefaultHostname:  "oauth.com",
+				CurrentHostnames: []string{"oauth.com"},
+			},
+		},
+	}
+	if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress status: %v", err)
+	}
+
+	listOptions := []client.ListOption{
+		client.MatchingLabels{
+			componentRouteHashLabelKey: util.Hash("default/oauth"),
+		},
+	}
+	roleBindingList := &rbacv1.RoleBindingList{}
+	err := wait.PollImmediate(1*time.Second, 10*time.Second, func() (bool, error) {
+		if err := kclient.List(context.TODO(), roleBindingList, listOptions...); err != nil {
+			return false, nil
+		}
+		if len(roleBindingList.Items) != 1 || len(roleBindingList.Items[0].Subjects) != 1 {
+			return false, nil
+		}
+
+		subject := roleBindingList.Items[0].Subjects[0]
+		return subject.Kind == "ServiceAccount" && subject.Namespace == "openshift-authentication" && subject.Name == "oauth-openshift", nil
+	})
+	if err != nil {
+		t.Errorf("roleBinding subject was not well-formed: %v", err)
+	}
+}
+
+// TestIngressConfigEvents verifies that role and roleBinding creation for a
+// componentRoute is surfaced as a Kubernetes Event on the cluster ingress
+// config, in addition to the Role/RoleBinding resources themselves.
+func TestIngressConfigEvents(t *testing.T) {
+	ingress := &configv1.Ingress{}
+	if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+		t.Fatalf("failed to get ingress resource: %v", err)
+	}
+
+	defer func() {
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+			t.Fatalf("failed to get ingress resource: %v", err)
+		}
+		ingress.Spec.ComponentRoutes = nil
+		if err := kclient.Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+		ingress.Status.ComponentRoutes = nil
+		if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+	}()
+
+	ingress.Spec.ComponentRoutes = []configv1.ComponentRouteSpec{
+		{
+			Namespace: "default",
+			Name:      "events-test",
+			Hostname:  "events-test.testing.com",
+			ServingCertKeyPairSecret: configv1.SecretNameReference{
+				Name: "events-test",
+			},
+		},
+	}
+	if err := kclient.Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress resource: %v", err)
+	}
+
+	ingress.Status = configv1.IngressStatus{
+		ComponentRoutes: []configv1.ComponentRouteStatus{
+			{
+				Namespace:        "default",
+				Name:             "events-test",
+				ConsumingUsers:   []string{"foo"},
+				DefaultHostname:  "events-test.com",
+				CurrentHostnames: []string{"events-test.com"},
+			},
+		},
+	}
+	if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress status: %v", err)
+	}
+
+	err := wait.PollImmediate(1*time.Second, 30*time.Second, func() (bool, error) {
+		eventList := &corev1.EventList{}
+		if err := kclient.List(context.TODO(), eventList); err != nil {
+			return false, nil
+		}
+		for _, event := range eventList.Items {
+			if event.InvolvedObject.Name != "cluster" {
+				continue
+			}
+			if event.Reason == "RoleCreated" || event.Reason == "RoleBindingCreated" {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Errorf("did not observe a RoleCreated or RoleBindingCreated event on the ingress resource: %v", err)
+	}
+}
+
+func pollForDegradedCondition(t *testing.T, namespace, name string) error {
+	return wait.PollImmediate(1*time.Second, 30*time.Second, func() (bool, error) {
+		ingress := &configv1.Ingress{}
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+			return false, nil
+		}
+
+		for _, componentRoute := range ingress.Status.ComponentRoutes {
+			if componentRoute.Namespace != namespace || componentRoute.Name != name {
+				continue
+			}
+			for _, condition := range componentRoute.Conditions {
+				if condition.Type == "Degraded" && condition.Status == configv1.ConditionTrue {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	})
+}
+
+// TestIngressConfigSNIMappings tests that a componentRoute with an SNIMapping
+// gets its mapping's servingCertKeyPairSecret mirrored to the consumer
+// namespace and its hostname reflected in CurrentHostnames, and that an
+// SNIMapping referencing a missing secret is reported as degraded without
+// affecting the componentRoute's primary hostname.
+func TestIngressConfigSNIMappings(t *testing.T) {
+	const consumerNamespace = "default"
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sni-test",
+			Namespace: secretNamespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: selfSignedTLSSecretData(t, "sni-test.testing.com"),
+	}
+	if err := kclient.Create(context.TODO(), secret); err != nil {
+		t.Fatalf("failed to create source secret: %v", err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), secret); err != nil {
+			t.Errorf("failed to delete source secret: %v", err)
+		}
+	}()
+
+	sniSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sni-test-alt",
+			Namespace: secretNamespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: selfSignedTLSSecretData(t, "sni-test-alt.testing.com"),
+	}
+	if err := kclient.Create(context.TODO(), sniSecret); err != nil {
+		t.Fatalf("failed to create sniMapping secret: %v", err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), sniSecret); err != nil {
+			t.Errorf("failed to delete sniMapping secret: %v", err)
+		}
+	}()
+
+	ingress := &configv1.Ingress{}
+	if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+		t.Fatalf("failed to get ingress resource: %v", err)
+	}
+
+	defer func() {
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+			t.Fatalf("failed to get ingress resource: %v", err)
+		}
+		ingress.Spec.ComponentRoutes = nil
+		if err := kclient.Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+		ingress.Status.ComponentRoutes = nil
+		if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+	}()
+
+	ingress.Spec.ComponentRoutes = []configv1.ComponentRouteSpec{
+		{
+			Namespace: "default",
+			Name:      "sni-test",
+			Hostname:  "sni-test.testing.com",
+			ServingCertKeyPairSecret: configv1.SecretNameReference{
+				Name: secret.Name,
+			},
+			SNIMappings: []configv1.SNIMapping{
+				{
+					Hostname: "sni-test-alt.testing.com",
+					ServingCertKeyPairSecret: configv1.SecretNameReference{
+						Name: sniSecret.Name,
+					},
+				},
+				{
+					Hostname: "sni-test-missing.testing.com",
+					ServingCertKeyPairSecret: configv1.SecretNameReference{
+						Name: "sni-test-does-not-exist",
+					},
+				},
+			},
+		},
+	}
+	if err := kclient.Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress resource: %v", err)
+	}
+
+	ingress.Status = configv1.IngressStatus{
+		ComponentRoutes: []configv1.ComponentRouteStatus{
+			{
+				Namespace:        "default",
+				Name:             "sni-test",
+				ConsumingUsers:   []string{fmt.Sprintf("system:serviceaccount:%s:sni-test-consumer", consumerNamespace)},
+				DefaultHostname:  "sni-test.testing.com",
+				CurrentHostnames: []string{"sni-test.testing.com"},
+			},
+		},
+	}
+	if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress status: %v", err)
+	}
+
+	if err := pollForMirroredSecretData(t, consumerNamespace, sniSecret.Name, sniSecret.Data); err != nil {
+		t.Fatalf("sniMapping secret was not mirrored: %v", err)
+	}
+
+	err := wait.PollImmediate(1*time.Second, 30*time.Second, func() (bool, error) {
+		current := &configv1.Ingress{}
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, current); err != nil {
+			return false, nil
+		}
+		for _, componentRoute := range current.Status.ComponentRoutes {
+			if componentRoute.Namespace != "default" || componentRoute.Name != "sni-test" {
+				continue
+			}
+			liveHostnames := map[string]bool{}
+			for _, hostname := range componentRoute.CurrentHostnames {
+				liveHostnames[hostname] = true
+			}
+			if !liveHostnames["sni-test.testing.com"] || !liveHostnames["sni-test-alt.testing.com"] {
+				return false, nil
+			}
+			if liveHostnames["sni-test-missing.testing.com"] {
+				return false, nil
+			}
+			for _, condition := range componentRoute.Conditions {
+				if condition.Type == "SNIMappingDegraded/sni-test-missing.testing.com" && condition.Status == configv1.ConditionTrue {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Errorf("sniMappings were not reconciled as expected: %v", err)
+	}
+}
+
+// TestIngressConfigCABundleDistribution tests that the controller publishes
+// a ComponentRoute's CA bundle to a configmap in openshift-config-managed,
+// that TrustDistributed goes true once it is published, and that
+// CurrentCABundle records the configmap's name.
+func TestIngressConfigCABundleDistribution(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca-bundle-test",
+			Namespace: secretNamespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: selfSignedTLSSecretData(t, "ca-bundle-test.testing.com"),
+	}
+	if err := kclient.Create(context.TODO(), secret); err != nil {
+		t.Fatalf("failed to create source secret: %v", err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), secret); err != nil {
+			t.Errorf("failed to delete source secret: %v", err)
+		}
+	}()
+
+	ingress := &configv1.Ingress{}
+	if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+		t.Fatalf("failed to get ingress resource: %v", err)
+	}
+
+	defer func() {
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, ingress); err != nil {
+			t.Fatalf("failed to get ingress resource: %v", err)
+		}
+		ingress.Spec.ComponentRoutes = nil
+		if err := kclient.Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+		ingress.Status.ComponentRoutes = nil
+		if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+			t.Errorf("failed to restore cluster ingress resource to original state: %v", err)
+		}
+
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-config-managed", Name: "default-ca-bundle-test"}}
+		if err := kclient.Delete(context.TODO(), configMap); err != nil {
+			t.Errorf("failed to clean up CA bundle configmap: %v", err)
+		}
+	}()
+
+	ingress.Spec.ComponentRoutes = []configv1.ComponentRouteSpec{
+		{
+			Namespace: "default",
+			Name:      "ca-bundle-test",
+			Hostname:  "ca-bundle-test.testing.com",
+			ServingCertKeyPairSecret: configv1.SecretNameReference{
+				Name: secret.Name,
+			},
+		},
+	}
+	if err := kclient.Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress resource: %v", err)
+	}
+
+	ingress.Status = configv1.IngressStatus{
+		ComponentRoutes: []configv1.ComponentRouteStatus{
+			{
+				Namespace:        "default",
+				Name:             "ca-bundle-test",
+				ConsumingUsers:   []string{"system:serviceaccount:default:ca-bundle-test-consumer"},
+				DefaultHostname:  "ca-bundle-test.com",
+				CurrentHostnames: []string{"ca-bundle-test.testing.com"},
+			},
+		},
+	}
+	if err := kclient.Status().Update(context.TODO(), ingress); err != nil {
+		t.Fatalf("failed to update ingress status: %v", err)
+	}
+
+	err := wait.PollImmediate(1*time.Second, 30*time.Second, func() (bool, error) {
+		configMap := &corev1.ConfigMap{}
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "openshift-config-managed", Name: "default-ca-bundle-test"}, configMap); err != nil {
+			return false, nil
+		}
+		if configMap.Data["ca-bundle.crt"] == "" {
+			return false, nil
+		}
+
+		current := &configv1.Ingress{}
+		if err := kclient.Get(context.TODO(), types.NamespacedName{Namespace: "", Name: "cluster"}, current); err != nil {
+			return false, nil
+		}
+		for _, componentRoute := range current.Status.ComponentRoutes {
+			if componentRoute.Namespace != "default" || componentRoute.Name != "ca-bundle-test" {
+				continue
+			}
+			if componentRoute.CurrentCABundle.Name != "default-ca-bundle-test" {
+				return false, nil
+			}
+			for _, condition := range componentRoute.Conditions {
+				if condition.Type == "TrustDistributed" && condition.Status == configv1.ConditionTrue {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Errorf("CA bundle was not distributed as expected: %v", err)
+	}
+
+	roleBindingList := &rbacv1.RoleBindingList{}
+	if err := kclient.List(context.TODO(), roleBindingList, client.InNamespace("openshift-config-managed"), client.MatchingLabels{componentRouteHashLabelKey: util.Hash("default/ca-bundle-test")}); err != nil {
+		t.Fatalf("failed to list CA bundle roleBindings: %v", err)
+	}
+	if len(roleBindingList.Items) != 1 || len(roleBindingList.Items[0].Subjects) != 1 {
+		t.Errorf("expected exactly one roleBinding granting exactly one subject access to the CA bundle configmap, got %+v", roleBindingList.Items)
+	}
+}
@@ -53,8 +53,42 @@ type IngressSpec struct {
 	// not have an effect.  If an operator later reads the field, it will eventually (but not necessarily immediately)
 	// honor the pre-existing spec values.
 	ComponentRoutes []ComponentRouteSpec `json:"componentRoutes,omitempty"`
+
+	// aws contains AWS-specific settings for the cluster ingress. It is ignored on non-AWS platforms.
+	// +optional
+	AWS *AWSIngressSpec `json:"aws,omitempty"`
+
+	// metricsTLS, once set, causes the operator to issue and rotate a
+	// service-CA-signed client certificate for Prometheus to use when
+	// scraping the router's /metrics endpoint, and to configure that
+	// endpoint to require client-cert auth, replacing the bearer-token
+	// based scrape auth used today.
+	// +optional
+	MetricsTLS *IngressMetricsTLSConfig `json:"metricsTLS,omitempty"`
 }
 
+// IngressMetricsTLSConfig opts the router's Prometheus metrics endpoint into
+// mTLS scraping. It has no fields today; its presence alone is the toggle,
+// matching the rest of this API's use of an optional, otherwise-empty block
+// to enable a feature.
+type IngressMetricsTLSConfig struct {
+}
+
+// AWSIngressSpec holds AWS-specific ingress configuration.
+type AWSIngressSpec struct {
+	// eipAllocations is a list of AWS EIP allocation IDs to assign to the default ingresscontroller's
+	// network load balancer, one per availability zone the load balancer spans. Customers with strict
+	// firewall policies use this to pin ingress to known, static IPs. This field is only honored when the
+	// default ingresscontroller's load balancer type is NLB; it is rejected otherwise.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	EIPAllocations []EIPAllocation `json:"eipAllocations,omitempty"`
+}
+
+// EIPAllocation is an AWS EIP allocation ID, of the form "eipalloc-" followed by 8 to 17 hex digits.
+// +kubebuilder:validation:Pattern=`^eipalloc-[0-9a-f]{8,17}$`
+type EIPAllocation string
+
 type IngressStatus struct {
 	// ComponentRoutes is where participating operators place the current route status for routes which the cluster-admin
 	// can customize hostnames and serving certificates.
@@ -62,6 +96,29 @@ type IngressStatus struct {
 	// An operator that creates entries in this slice should clean them up during removal (if it can be removed).
 	// An operator must also handle the case of deleted status without churn.
 	ComponentRoutes []ComponentRouteStatus `json:"componentRoutes,omitempty"`
+
+	// metricsTLS reports the current state of the router metrics client
+	// certificate requested via Spec.MetricsTLS, including its validity window
+	// and whether the most recent rotation succeeded.
+	// +optional
+	MetricsTLS *IngressMetricsTLSStatus `json:"metricsTLS,omitempty"`
+}
+
+// IngressMetricsTLSStatus reports the validity window of the
+// router-metrics-client-certs secret's current client certificate and the
+// conditions describing its rotation.
+type IngressMetricsTLSStatus struct {
+	// notBefore is the NotBefore timestamp of the current client certificate.
+	// +optional
+	NotBefore metav1.Time `json:"notBefore,omitempty"`
+	// notAfter is the NotAfter timestamp of the current client certificate.
+	// +optional
+	NotAfter metav1.Time `json:"notAfter,omitempty"`
+	// conditions includes ClientCertRotated, which is True as soon as the
+	// client certificate has been issued or re-issued and False if the most
+	// recent rotation attempt failed.
+	// +optional
+	Conditions []ClusterOperatorStatusCondition `json:"conditions,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -95,7 +152,27 @@ type ComponentRouteSpec struct {
 	// SANs in the certificate are ignored, but SNI can be used to make operator managed certificates (like internal load balancers
 	// and service serving certificates) serve correctly.
 	ServingCertKeyPairSecret SecretNameReference `json:"servingCertKeyPairSecret,omitempty"`
-	// possible future, we could add a set of SNI mappings.  I suspect most operators would not properly handle it today.
+	// sniMappings is an optional list of additional hostnames this ComponentRoute should be served for, each with its
+	// own serving cert/key pair. This is for operands that must serve more than one hostname (for example, because
+	// wildcard certs are disallowed in the environment) and therefore cannot be satisfied by servingCertKeyPairSecret
+	// and hostname alone.
+	// +optional
+	SNIMappings []SNIMapping `json:"sniMappings,omitempty"`
+}
+
+// SNIMapping pairs a hostname (or hostname pattern) with the serving cert/key pair that should be presented for it
+// via SNI, in addition to the ComponentRouteSpec's primary hostname and servingCertKeyPairSecret.
+type SNIMapping struct {
+	// hostname is the host name (or pattern) that this mapping's servingCertKeyPairSecret should be presented for.
+	// +kubebuilder:validation:Required
+	// +required
+	Hostname string `json:"hostname"`
+	// servingCertKeyPairSecret is a reference to a secret in namespace/openshift-config that is a kubernetes tls secret.
+	// The serving cert/key pair must match hostname and will be used by the operator to fulfill the intent of serving
+	// with this name via SNI.
+	// +kubebuilder:validation:Required
+	// +required
+	ServingCertKeyPairSecret SecretNameReference `json:"servingCertKeyPairSecret"`
 }
 
 type ComponentRouteStatus struct {
@@ -127,8 +204,19 @@ type ComponentRouteStatus struct {
 	// They may be aggregated into an overall status RelatedObjects to be automatically shown by oc adm inspect
 	RelatedObjects []corev1.ObjectReference `json:"rrelatedObjects,omitempty"`
 
-	// This API does not include a mechanism to distribute trust, since the ability to write this resource would then
-	// allow interception.  Instead, if we need such a mechanism, we can talk about creating a way to allow narrowly scoped
-	// updates to a configmap containing ca-bundle.crt for each ComponentRoute.
-	// CurrentCABundle []byte
+	// currentCABundle is a reference to a configmap in openshift-config-managed named
+	// "{namespace}-{name}" containing a ca-bundle.crt key with the CA material the ComponentRoute's
+	// ConsumingUsers should trust. Only the ingress operator writes this configmap; ConsumingUsers are
+	// only ever granted get/watch on it, since allowing a consumer to write its own trust bundle would
+	// open the door to the interception attack this API has always tried to avoid.
+	// +optional
+	CurrentCABundle ConfigMapNameReference `json:"currentCABundle,omitempty"`
+}
+
+// ConfigMapNameReference holds a reference to a ConfigMap by name, analogous to SecretNameReference.
+type ConfigMapNameReference struct {
+	// name is the metadata.name of the referenced configmap
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name"`
 }